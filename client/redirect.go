@@ -0,0 +1,243 @@
+package client
+
+import (
+	"context"
+	"raft-grpc-demo/ecode"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRedirects bounds how often a call follows a leader hint
+const maxRedirects = 2
+
+// followerReads are the methods followers serve depending on the request,
+// e.g. on its consistency level. Their hints are followed per call.
+var followerReads = map[string]bool{
+	"Get":   true,
+	"Range": true,
+}
+
+// LeaderRedirect makes a grpc client follow the leader hints of nodes in
+// redirect mode, see ecode.NotLeader. The leader is cached: writes and admin
+// methods that were redirected once are sent straight to it from then on.
+// Reads follow the hints per call, so that stale and bounded reads keep
+// going to the nodes dialed.
+type LeaderRedirect struct {
+	mu      sync.Mutex
+	leader  string
+	methods map[string]bool
+	conns   map[string]*grpc.ClientConn
+}
+
+func NewLeaderRedirect() *LeaderRedirect {
+	return &LeaderRedirect{
+		methods: make(map[string]bool),
+		conns:   make(map[string]*grpc.ClientConn),
+	}
+}
+
+// DialOptions returns the options to dial the nodes with
+func (r *LeaderRedirect) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(r.unary),
+		grpc.WithChainStreamInterceptor(r.stream),
+	}
+}
+
+// Leader returns the cached grpc address of the leader, empty if unknown
+func (r *LeaderRedirect) Leader() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.leader
+}
+
+// Close closes the connections to the leaders
+func (r *LeaderRedirect) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for addr, cc := range r.conns {
+		cc.Close()
+		delete(r.conns, addr)
+	}
+	r.leader = ""
+}
+
+// target returns the cached leader and its connection if method goes to it
+func (r *LeaderRedirect) target(method string) (string, *grpc.ClientConn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.leader == "" || !r.methods[method] {
+		return "", nil
+	}
+	return r.leader, r.conns[r.leader]
+}
+
+// follow caches leader after method was redirected to it and returns the
+// connection to it. The connection has no interceptors, redirects of calls
+// on it are followed by the interceptors of the original connection.
+func (r *LeaderRedirect) follow(method, leader string) (*grpc.ClientConn, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cc, ok := r.conns[leader]
+	if !ok {
+		var err error
+		if cc, err = grpc.Dial(leader, grpc.WithInsecure()); err != nil {
+			return nil, err
+		}
+		r.conns[leader] = cc
+	}
+	r.leader = leader
+	if !followerReads[method[strings.LastIndex(method, "/")+1:]] {
+		r.methods[method] = true
+	}
+	return cc, nil
+}
+
+// forget drops leader from the cache if err tells it is unavailable, the
+// call is then sent again to the nodes dialed to learn the new leader. The
+// connection is kept, other calls may still use it, and grpc reconnects it
+// should the node lead again.
+func (r *LeaderRedirect) forget(leader string, err error) bool {
+	if status.Code(err) != codes.Unavailable {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.leader == leader {
+		r.leader = ""
+	}
+	return true
+}
+
+func (r *LeaderRedirect) unary(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var err error
+	addr, leader := r.target(method)
+	if leader != nil {
+		err = leader.Invoke(ctx, method, req, reply, opts...)
+	}
+	if leader == nil || r.forget(addr, err) {
+		err = invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	for i := 0; i < maxRedirects; i++ {
+		hint, ok := ecode.LeaderHint(err)
+		if !ok {
+			return err
+		}
+		if leader, err = r.follow(method, hint); err != nil {
+			return err
+		}
+		err = leader.Invoke(ctx, method, req, reply, opts...)
+	}
+	return err
+}
+
+func (r *LeaderRedirect) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	rs := &redirectStream{r: r, ctx: ctx, desc: desc, cc: cc, method: method, streamer: streamer, opts: opts}
+	var err error
+	addr, leader := r.target(method)
+	if leader != nil {
+		rs.ClientStream, err = leader.NewStream(ctx, desc, method, opts...)
+		rs.leader = addr
+		if r.forget(addr, err) {
+			rs.leader = ""
+			rs.ClientStream, err = streamer(ctx, desc, cc, method, opts...)
+		}
+	} else {
+		rs.ClientStream, err = streamer(ctx, desc, cc, method, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// redirectStream follows a leader hint received before any response: the
+// stream is opened again on the leader, and the requests sent so far are
+// sent again. A cached leader found unavailable the same way is replaced by
+// the nodes dialed.
+type redirectStream struct {
+	grpc.ClientStream
+	r        *LeaderRedirect
+	ctx      context.Context
+	desc     *grpc.StreamDesc
+	cc       *grpc.ClientConn
+	method   string
+	streamer grpc.Streamer
+	opts     []grpc.CallOption
+	leader   string // cached leader the stream is open on, if any
+	sent     []interface{}
+	closed   bool
+	received bool
+	hops     int
+}
+
+func (s *redirectStream) SendMsg(m interface{}) error {
+	if !s.received {
+		s.sent = append(s.sent, m)
+	}
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *redirectStream) CloseSend() error {
+	s.closed = true
+	return s.ClientStream.CloseSend()
+}
+
+func (s *redirectStream) RecvMsg(m interface{}) error {
+	for {
+		err := s.ClientStream.RecvMsg(m)
+		if err == nil {
+			s.received = true
+			s.sent = nil
+			return nil
+		}
+		if s.received || s.hops == maxRedirects {
+			return err
+		}
+		if s.leader != "" && s.r.forget(s.leader, err) {
+			s.leader = ""
+			s.hops++
+			if err := s.reopen(s.streamer(s.ctx, s.desc, s.cc, s.method, s.opts...)); err != nil {
+				return err
+			}
+			continue
+		}
+		hint, ok := ecode.LeaderHint(err)
+		if !ok {
+			return err
+		}
+		s.hops++
+		cc, err := s.r.follow(s.method, hint)
+		if err != nil {
+			return err
+		}
+		s.leader = hint
+		if err := s.reopen(cc.NewStream(s.ctx, s.desc, s.method, s.opts...)); err != nil {
+			return err
+		}
+	}
+}
+
+// reopen replaces the stream by cs and sends the requests sent so far on it
+func (s *redirectStream) reopen(cs grpc.ClientStream, err error) error {
+	if err != nil {
+		return err
+	}
+	for _, m := range s.sent {
+		if err := cs.SendMsg(m); err != nil {
+			return err
+		}
+	}
+	if s.closed {
+		if err := cs.CloseSend(); err != nil {
+			return err
+		}
+	}
+	s.ClientStream = cs
+	return nil
+}
@@ -0,0 +1,286 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix prefixes the environment variables overriding the settings. The
+// variable of a setting is its flag name in upper case, e.g. RAFT_DEMO_ID.
+const EnvPrefix = "RAFT_DEMO_"
+
+// Config is the configuration of a node. It is read from the defaults, then
+// the config file, then the environment, then the command line flags, each
+// overriding the previous ones.
+type Config struct {
+	Node     Node     `yaml:"node"`
+	Raft     Raft     `yaml:"raft"`
+	Snapshot Snapshot `yaml:"snapshot"`
+	GRPC     GRPC     `yaml:"grpc"`
+	Register Register `yaml:"register"`
+}
+
+// Node is the identity and the addresses of the node
+type Node struct {
+	ID          string        `yaml:"id"`
+	GrpcAddr    string        `yaml:"grpc_addr"`
+	RaftAddr    string        `yaml:"raft_addr"`
+	DataDir     string        `yaml:"data_dir"`
+	JoinAddr    string        `yaml:"join_addr"`    // JoinAddr is the grpc address of a node to join, empty to bootstrap
+	Nonvoter    bool          `yaml:"nonvoter"`     // Nonvoter joins the cluster as a nonvoter, to be promoted once caught up
+	Engine      string        `yaml:"engine"`       // Engine is the state machine storage engine: memory or bolt
+	JoinTimeout time.Duration `yaml:"join_timeout"` // JoinTimeout bounds the Join call to JoinAddr
+	OpenTimeout time.Duration `yaml:"open_timeout"` // OpenTimeout bounds the wait for a leader and the initial logs
+	// ShutdownTimeout bounds the wait for running RPCs on shutdown
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// Raft tunes the raft library, see raft.Config
+type Raft struct {
+	HeartbeatTimeout   time.Duration `yaml:"heartbeat_timeout"`
+	ElectionTimeout    time.Duration `yaml:"election_timeout"`
+	CommitTimeout      time.Duration `yaml:"commit_timeout"`
+	LeaderLeaseTimeout time.Duration `yaml:"leader_lease_timeout"`
+	MaxAppendEntries   int           `yaml:"max_append_entries"`
+}
+
+// Snapshot is the snapshot policy and format
+type Snapshot struct {
+	Interval     time.Duration `yaml:"interval"`
+	Threshold    uint64        `yaml:"threshold"`
+	TrailingLogs uint64        `yaml:"trailing_logs"`
+	Retain       int           `yaml:"retain"`
+	Codec        string        `yaml:"codec"`    // Codec is none, flate or gzip
+	Checksum     string        `yaml:"checksum"` // Checksum is crc32c or sha256
+}
+
+// GRPC limits the grpc server of the node
+type GRPC struct {
+	MaxRecvMsgSize       int    `yaml:"max_recv_msg_size"`
+	MaxSendMsgSize       int    `yaml:"max_send_msg_size"`
+	MaxConcurrentStreams uint   `yaml:"max_concurrent_streams"` // MaxConcurrentStreams is per connection, 0 means no limit
+	Forward              string `yaml:"forward"`                // Forward is how followers answer leader-only rpcs: proxy or redirect
+}
+
+// Register is how the node registers itself to the register center
+type Register struct {
+	Addr    string        `yaml:"addr"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// Default returns the configuration used when nothing overrides it
+func Default() *Config {
+	return &Config{
+		Node: Node{
+			GrpcAddr:        "localhost:51000",
+			RaftAddr:        "localhost:52000",
+			DataDir:         "data/",
+			Engine:          "memory",
+			JoinTimeout:     2 * time.Second,
+			OpenTimeout:     120 * time.Second,
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Raft: Raft{
+			HeartbeatTimeout:   1000 * time.Millisecond,
+			ElectionTimeout:    1000 * time.Millisecond,
+			CommitTimeout:      50 * time.Millisecond,
+			LeaderLeaseTimeout: 500 * time.Millisecond,
+			MaxAppendEntries:   64,
+		},
+		Snapshot: Snapshot{
+			Interval:     120 * time.Second,
+			Threshold:    8192,
+			TrailingLogs: 10240,
+			Retain:       2,
+			Codec:        "none",
+			Checksum:     "crc32c",
+		},
+		GRPC: GRPC{
+			MaxRecvMsgSize: 4 << 20,
+			MaxSendMsgSize: math.MaxInt32,
+			Forward:        "proxy",
+		},
+		Register: Register{
+			Addr:    "localhost:50000",
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// RegisterFlags binds the settings to flags of fs
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.Node.ID, "id", c.Node.ID, "node id used by Raft")
+	fs.StringVar(&c.Node.GrpcAddr, "svc", c.Node.GrpcAddr, "service host:port for this node")
+	fs.StringVar(&c.Node.RaftAddr, "raft", c.Node.RaftAddr, "raft host:port for this node")
+	fs.StringVar(&c.Node.DataDir, "data", c.Node.DataDir, "raft data dir")
+	fs.StringVar(&c.Node.JoinAddr, "join", c.Node.JoinAddr, "join address")
+	fs.BoolVar(&c.Node.Nonvoter, "nonvoter", c.Node.Nonvoter, "join as a nonvoter that does not count towards the quorum")
+	fs.StringVar(&c.Node.Engine, "engine", c.Node.Engine, "state machine storage engine: memory or bolt")
+	fs.DurationVar(&c.Node.JoinTimeout, "join_timeout", c.Node.JoinTimeout, "timeout of the join request")
+	fs.DurationVar(&c.Node.OpenTimeout, "open_timeout", c.Node.OpenTimeout, "timeout waiting for a leader and the initial logs")
+	fs.DurationVar(&c.Node.ShutdownTimeout, "shutdown_timeout", c.Node.ShutdownTimeout, "timeout waiting for running rpcs on shutdown")
+
+	fs.DurationVar(&c.Raft.HeartbeatTimeout, "heartbeat_timeout", c.Raft.HeartbeatTimeout, "raft heartbeat timeout")
+	fs.DurationVar(&c.Raft.ElectionTimeout, "election_timeout", c.Raft.ElectionTimeout, "raft election timeout")
+	fs.DurationVar(&c.Raft.CommitTimeout, "commit_timeout", c.Raft.CommitTimeout, "raft commit timeout")
+	fs.DurationVar(&c.Raft.LeaderLeaseTimeout, "leader_lease_timeout", c.Raft.LeaderLeaseTimeout, "raft leader lease timeout")
+	fs.IntVar(&c.Raft.MaxAppendEntries, "max_append_entries", c.Raft.MaxAppendEntries, "max log entries sent in one append entries request")
+
+	fs.DurationVar(&c.Snapshot.Interval, "snapshot_interval", c.Snapshot.Interval, "how often raft checks whether to take a snapshot")
+	fs.Uint64Var(&c.Snapshot.Threshold, "snapshot_threshold", c.Snapshot.Threshold, "number of new log entries that trigger a snapshot")
+	fs.Uint64Var(&c.Snapshot.TrailingLogs, "trailing_logs", c.Snapshot.TrailingLogs, "number of log entries kept behind a snapshot")
+	fs.IntVar(&c.Snapshot.Retain, "snapshot_retain", c.Snapshot.Retain, "number of snapshots kept on disk")
+	fs.StringVar(&c.Snapshot.Codec, "snapshot_codec", c.Snapshot.Codec, "raft snapshot compression: none, flate or gzip")
+	fs.StringVar(&c.Snapshot.Checksum, "snapshot_checksum", c.Snapshot.Checksum, "raft snapshot checksum: crc32c or sha256")
+
+	fs.IntVar(&c.GRPC.MaxRecvMsgSize, "grpc_max_recv_msg_size", c.GRPC.MaxRecvMsgSize, "max size in bytes of a grpc request")
+	fs.IntVar(&c.GRPC.MaxSendMsgSize, "grpc_max_send_msg_size", c.GRPC.MaxSendMsgSize, "max size in bytes of a grpc response")
+	fs.UintVar(&c.GRPC.MaxConcurrentStreams, "grpc_max_concurrent_streams", c.GRPC.MaxConcurrentStreams, "max concurrent grpc streams per connection, 0 for no limit")
+	fs.StringVar(&c.GRPC.Forward, "grpc_forward", c.GRPC.Forward, "how followers answer leader-only rpcs: proxy to the leader or redirect the caller to it")
+
+	fs.StringVar(&c.Register.Addr, "service_join", c.Register.Addr, "raft register center port")
+	fs.DurationVar(&c.Register.Timeout, "register_timeout", c.Register.Timeout, "timeout of the registration to the register center")
+}
+
+// Load parses args with fs and returns the resulting configuration. The
+// config file is given by the -config flag or the RAFT_DEMO_CONFIG variable.
+// Every flag of fs, including the ones not bound to the configuration, can
+// be set from the environment.
+func Load(fs *flag.FlagSet, args []string) (*Config, error) {
+	c := Default()
+	var path string
+	fs.StringVar(&path, "config", os.Getenv(EnvPrefix+"CONFIG"), "config file, YAML or JSON")
+	c.RegisterFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	// Remember the command line before the file and the environment
+	// overwrite the settings, it is applied again last.
+	cmdline := make(map[string]string)
+	fs.Visit(func(f *flag.Flag) {
+		cmdline[f.Name] = f.Value.String()
+	})
+
+	if path != "" {
+		if err := c.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" || err != nil {
+			return
+		}
+		name := EnvPrefix + strings.ToUpper(f.Name)
+		if v, ok := os.LookupEnv(name); ok {
+			if e := f.Value.Set(v); e != nil {
+				err = fmt.Errorf("invalid value %q for %s: %v", v, name, e)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for name, v := range cmdline {
+		if err := fs.Set(name, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// loadFile overrides the settings found in the file at path. JSON being a
+// subset of YAML, both are read by the YAML decoder.
+func (c *Config) loadFile(path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %v", err)
+	}
+	if err := yaml.Unmarshal(b, c); err != nil {
+		return fmt.Errorf("parse config file %s: %v", path, err)
+	}
+	return nil
+}
+
+// Validate reports every invalid setting at once. Settings checked by the
+// raft library are validated by core.Store.Validate.
+func (c *Config) Validate() error {
+	var errs []string
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Sprintf(format, args...))
+	}
+
+	if c.Node.ID == "" {
+		fail("node.id is required")
+	}
+	if c.Node.DataDir == "" {
+		fail("node.data_dir is required")
+	}
+	addrs := []struct {
+		name, addr string
+		optional   bool
+	}{
+		{"node.grpc_addr", c.Node.GrpcAddr, false},
+		{"node.raft_addr", c.Node.RaftAddr, false},
+		{"node.join_addr", c.Node.JoinAddr, true},
+		{"register.addr", c.Register.Addr, false},
+	}
+	for _, a := range addrs {
+		if a.addr == "" && a.optional {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(a.addr); err != nil {
+			fail("%s %q is not a host:port address", a.name, a.addr)
+		}
+	}
+	if c.Node.Nonvoter && c.Node.JoinAddr == "" {
+		fail("node.nonvoter requires node.join_addr")
+	}
+	if c.Node.JoinTimeout <= 0 {
+		fail("node.join_timeout must be positive")
+	}
+	if c.Node.OpenTimeout <= 0 {
+		fail("node.open_timeout must be positive")
+	}
+	if c.Node.ShutdownTimeout <= 0 {
+		fail("node.shutdown_timeout must be positive")
+	}
+	if c.Register.Timeout <= 0 {
+		fail("register.timeout must be positive")
+	}
+	if c.Snapshot.Retain < 1 {
+		fail("snapshot.retain must be at least 1")
+	}
+	if c.GRPC.MaxRecvMsgSize <= 0 {
+		fail("grpc.max_recv_msg_size must be positive")
+	}
+	if c.GRPC.MaxSendMsgSize <= 0 {
+		fail("grpc.max_send_msg_size must be positive")
+	}
+	if c.GRPC.MaxConcurrentStreams > math.MaxUint32 {
+		fail("grpc.max_concurrent_streams must fit in 32 bits")
+	}
+	if c.GRPC.Forward != "proxy" && c.GRPC.Forward != "redirect" {
+		fail("grpc.forward %q is not proxy or redirect", c.GRPC.Forward)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
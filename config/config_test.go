@@ -0,0 +1,99 @@
+package config
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	yamlFile := filepath.Join(dir, "node.yaml")
+	assert.Nil(t, ioutil.WriteFile(yamlFile, []byte(`
+node:
+  id: n1
+  raft_addr: 10.0.0.1:52000
+  data_dir: /var/lib/raft
+raft:
+  heartbeat_timeout: 2s
+  max_append_entries: 32
+grpc:
+  max_recv_msg_size: 1048576
+  forward: redirect
+`), 0600))
+	jsonFile := filepath.Join(dir, "node.json")
+	assert.Nil(t, ioutil.WriteFile(jsonFile, []byte(`{"node": {"id": "n2"}, "snapshot": {"codec": "gzip"}}`), 0600))
+
+	load := func(args ...string) (*Config, error) {
+		return Load(flag.NewFlagSet("test", flag.ContinueOnError), args)
+	}
+
+	t.Run("file", func(t *testing.T) {
+		c, err := load("-config", yamlFile)
+		assert.Nil(t, err)
+		assert.Equal(t, "n1", c.Node.ID)
+		assert.Equal(t, "10.0.0.1:52000", c.Node.RaftAddr)
+		assert.Equal(t, "/var/lib/raft", c.Node.DataDir)
+		assert.Equal(t, 2*time.Second, c.Raft.HeartbeatTimeout)
+		assert.Equal(t, 32, c.Raft.MaxAppendEntries)
+		assert.Equal(t, 1<<20, c.GRPC.MaxRecvMsgSize)
+		assert.Equal(t, "redirect", c.GRPC.Forward)
+		// untouched settings keep their default
+		assert.Equal(t, Default().Node.GrpcAddr, c.Node.GrpcAddr)
+		assert.Equal(t, Default().Raft.ElectionTimeout, c.Raft.ElectionTimeout)
+
+		c, err = load("-config", jsonFile)
+		assert.Nil(t, err)
+		assert.Equal(t, "n2", c.Node.ID)
+		assert.Equal(t, "gzip", c.Snapshot.Codec)
+	})
+
+	t.Run("env overrides file, flags override env", func(t *testing.T) {
+		os.Setenv(EnvPrefix+"ID", "env")
+		os.Setenv(EnvPrefix+"HEARTBEAT_TIMEOUT", "3s")
+		defer os.Unsetenv(EnvPrefix + "ID")
+		defer os.Unsetenv(EnvPrefix + "HEARTBEAT_TIMEOUT")
+
+		c, err := load("-config", yamlFile, "-id", "flag")
+		assert.Nil(t, err)
+		assert.Equal(t, "flag", c.Node.ID)
+		assert.Equal(t, 3*time.Second, c.Raft.HeartbeatTimeout)
+		assert.Equal(t, 32, c.Raft.MaxAppendEntries)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := load("-raft", "localhost")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "node.id is required")
+		assert.Contains(t, err.Error(), `node.raft_addr "localhost" is not a host:port address`)
+
+		_, err = load("-id", "n1", "-nonvoter")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "node.nonvoter requires node.join_addr")
+
+		_, err = load("-id", "n1", "-grpc_forward", "relay")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), `grpc.forward "relay" is not proxy or redirect`)
+
+		os.Setenv(EnvPrefix+"ELECTION_TIMEOUT", "soon")
+		_, err = load("-id", "n1")
+		os.Unsetenv(EnvPrefix + "ELECTION_TIMEOUT")
+		assert.NotNil(t, err)
+
+		bad := filepath.Join(dir, "bad.yaml")
+		assert.Nil(t, ioutil.WriteFile(bad, []byte("raft: [1, 2]"), 0600))
+		_, err = load("-config", bad, "-id", "n1")
+		assert.NotNil(t, err)
+
+		_, err = load("-config", filepath.Join(dir, "missing.yaml"), "-id", "n1")
+		assert.NotNil(t, err)
+	})
+}
@@ -1,73 +1,263 @@
 package core
 
 import (
-	"encoding/json"
+	"encoding/base64"
 	"fmt"
 	"github.com/hashicorp/raft"
+	rpcservicepb "raft-grpc-demo/proto"
+	"strings"
+	"time"
 )
 
 //Get the kv store data
 func (s *Store) Get(k string, level ConsistencyLevel) (string, error) {
+	kv, err := s.GetKV(k, level)
+	if err != nil || kv == nil {
+		return "", err
+	}
+	return kv.Value, nil
+}
+
+//GetKV returns the key with its revisions, or nil if it does not exist
+func (s *Store) GetKV(k string, level ConsistencyLevel) (*KeyValue, error) {
+	if err := s.checkRead(level); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	e, ok, err := s.engine.get(k)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return newKeyValue(k, e, false), nil
+}
+
+//Range returns the keys selected by opts in ascending order
+func (s *Store) Range(opts RangeOptions, level ConsistencyLevel) (*RangeResult, error) {
+	start, end := opts.Start, opts.End
+	if opts.Prefix != "" {
+		start, end = opts.Prefix, prefixEnd(opts.Prefix)
+	}
+	if opts.Continue != "" {
+		next, err := decodeContinue(opts.Continue)
+		if err != nil || next < start || (end != "" && next >= end) {
+			return nil, ErrInvalidContinue
+		}
+		start = next
+	}
+
+	if err := s.checkRead(level); err != nil {
+		return nil, err
+	}
+
+	// The keys are scanned on a point in time view, a long range does not
+	// hold up the writes.
+	s.mutex.Lock()
+	r, err := s.engine.reader()
+	s.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	defer r.release()
+
+	rr := &RangeResult{}
+	err = r.ascend(start, end, func(k string, e kvEntry) bool {
+		if opts.CountOnly {
+			rr.Count++
+			return true
+		}
+		if opts.Limit > 0 && len(rr.KVs) == opts.Limit {
+			rr.More = true
+			rr.Continue = encodeContinue(k)
+			return false
+		}
+		rr.KVs = append(rr.KVs, newKeyValue(k, e, opts.KeysOnly))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rr, nil
+}
+
+// checkRead makes sure a read at the given level may be served locally.
+func (s *Store) checkRead(level ConsistencyLevel) error {
 	if level != Stale {
 		if s.raft.State() != raft.Leader {
-			return "", ErrNotLeader
+			return ErrNotLeader
 		}
 	}
 
-	if level == Consistent {
-		if err := s.consistentRead(); err != nil {
-			return "", err
-		}
+	switch level {
+	case Consistent:
+		return s.consistentRead()
+	case LeaseRead:
+		return s.leaseRead()
 	}
+	return nil
+}
 
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
+func newKeyValue(k string, e kvEntry, keysOnly bool) *KeyValue {
+	kv := &KeyValue{
+		Key:            k,
+		CreateRevision: e.CreateRevision,
+		ModRevision:    e.ModRevision,
+		Version:        e.Version,
+		Lease:          e.Lease,
+	}
+	if !keysOnly {
+		kv.Value = e.Value
+	}
+	return kv
+}
 
-	return s.m[k], nil
+// encodeContinue returns the opaque token resuming a range at key next.
+func encodeContinue(next string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(next))
+}
+
+func decodeContinue(token string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
 }
 
 //Set the kv store data
 func (s *Store) Set(k, v string) error {
-	if s.raft.State() != raft.Leader {
-		return ErrNotLeader
+	_, err := s.SetWithOptions(k, v, WriteOptions{})
+	return err
+}
+
+//SetWithOptions sets the kv store data if the preconditions in opts hold
+func (s *Store) SetWithOptions(k, v string, opts WriteOptions) (*WriteResult, error) {
+	if opts.TTL != 0 && opts.Lease != 0 {
+		return nil, ErrTTLWithLease
+	}
+	var ttl int64
+	if opts.TTL != 0 {
+		if ttl = int64(opts.TTL / time.Second); ttl < 1 {
+			return nil, ErrInvalidTTL
+		}
 	}
+	return s.applyWrite(&rpcservicepb.Command{
+		Op:           rpcservicepb.CommandOp_CMD_SET,
+		Key:          k,
+		Value:        v,
+		PrevRevision: opts.PrevRevision,
+		Ttl:          ttl,
+		Lease:        opts.Lease,
+	})
+}
 
-	c := &command{
-		Op:    "set",
+//Delete the kv store data
+func (s *Store) Delete(key string) error {
+	_, err := s.DeleteWithOptions(key, WriteOptions{})
+	return err
+}
+
+//DeleteWithOptions deletes the kv store data if the preconditions in opts hold
+func (s *Store) DeleteWithOptions(key string, opts WriteOptions) (*WriteResult, error) {
+	return s.applyWrite(&rpcservicepb.Command{
+		Op:           rpcservicepb.CommandOp_CMD_DELETE,
+		Key:          key,
+		PrevRevision: opts.PrevRevision,
+	})
+}
+
+//CompareAndSwap sets k to v only if it currently holds expected. The
+//comparison happens inside the fsm, so concurrent writers cannot interleave.
+func (s *Store) CompareAndSwap(k, expected, v string) (bool, error) {
+	return s.applyConditional(&rpcservicepb.Command{
+		Op:       rpcservicepb.CommandOp_CMD_COMPARE_AND_SWAP,
+		Key:      k,
+		Value:    v,
+		Expected: expected,
+	})
+}
+
+//SetIfAbsent sets k to v only if k does not exist yet
+func (s *Store) SetIfAbsent(k, v string) (bool, error) {
+	return s.applyConditional(&rpcservicepb.Command{
+		Op:    rpcservicepb.CommandOp_CMD_SET_IF_ABSENT,
 		Key:   k,
 		Value: v,
-	}
+	})
+}
 
-	b, err := json.Marshal(c)
+//DeleteIfValue deletes k only if it currently holds expected
+func (s *Store) DeleteIfValue(k, expected string) (bool, error) {
+	return s.applyConditional(&rpcservicepb.Command{
+		Op:       rpcservicepb.CommandOp_CMD_DELETE_IF_VALUE,
+		Key:      k,
+		Expected: expected,
+	})
+}
+
+func (s *Store) applyConditional(c *rpcservicepb.Command) (bool, error) {
+	r, err := s.applyWrite(c)
 	if err != nil {
-		return err
+		return false, err
+	}
+	return r.Succeeded, nil
+}
+
+func (s *Store) applyWrite(c *rpcservicepb.Command) (*WriteResult, error) {
+	if s.raft.State() != raft.Leader {
+		return nil, ErrNotLeader
 	}
 
-	f := s.raft.Apply(b, raftTimeout)
-	return f.Error()
+	resp, err := s.applyCommand(c)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*applyResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected fsm response %T", resp)
+	}
+	return &WriteResult{Succeeded: r.succeeded, Revision: r.revision}, nil
 }
 
-//Delete the kv store data
-func (s *Store) Delete(key string) error {
+//ApplyBatch commits all ops as a single raft log entry. The fsm applies
+//them atomically: readers observe either none or all of the writes.
+func (s *Store) ApplyBatch(ops []BatchOp) error {
 	if s.raft.State() != raft.Leader {
 		return ErrNotLeader
 	}
+	if len(ops) == 0 {
+		return ErrEmptyBatch
+	}
 
-	c := &command{
-		Op:  "delete",
-		Key: key,
+	c := &rpcservicepb.Command{
+		Op:  rpcservicepb.CommandOp_CMD_BATCH,
+		Ops: make([]*rpcservicepb.Command, 0, len(ops)),
 	}
-	b, err := json.Marshal(c)
-	if err != nil {
-		return err
+	for _, op := range ops {
+		switch op.Op {
+		case OpSet:
+			c.Ops = append(c.Ops, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: op.Key, Value: op.Value})
+		case OpDelete:
+			c.Ops = append(c.Ops, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_DELETE, Key: op.Key})
+		default:
+			return fmt.Errorf("unrecognized batch op: %d", op.Op)
+		}
 	}
 
-	f := s.raft.Apply(b, raftTimeout)
-	return f.Error()
+	_, err := s.applyCommand(c)
+	return err
 }
 
 //Join is used to join the raft cluster
-func (s *Store) Join(nodeID, grpcAddr, raftAddr string) error {
+func (s *Store) Join(nodeID, grpcAddr, raftAddr string, nonvoter bool) error {
+	if s.raft.State() != raft.Leader {
+		return ErrNotLeader
+	}
 	s.logger.Printf("received join request for remote node %s at %s", nodeID, raftAddr)
 	configuration := s.raft.GetConfiguration()
 	if err := configuration.Error(); err != nil {
@@ -78,6 +268,10 @@ func (s *Store) Join(nodeID, grpcAddr, raftAddr string) error {
 		if srv.ID == raft.ServerID(nodeID) || srv.Address == raft.ServerAddress(raftAddr) {
 			if srv.Address == raft.ServerAddress(raftAddr) && srv.ID == raft.ServerID(nodeID) {
 				s.logger.Printf("node %s at %s already member of cluster, ignoring join request", nodeID, raftAddr)
+				// The node may have moved its service address.
+				if cur, err := s.GetMeta(nodeID); err != nil || cur != grpcAddr {
+					return s.SetMeta(nodeID, grpcAddr)
+				}
 				return nil
 			}
 
@@ -88,7 +282,12 @@ func (s *Store) Join(nodeID, grpcAddr, raftAddr string) error {
 		}
 	}
 
-	f := s.raft.AddVoter(raft.ServerID(nodeID), raft.ServerAddress(raftAddr), 0, 0)
+	var f raft.IndexFuture
+	if nonvoter {
+		f = s.raft.AddNonvoter(raft.ServerID(nodeID), raft.ServerAddress(raftAddr), 0, 0)
+	} else {
+		f = s.raft.AddVoter(raft.ServerID(nodeID), raft.ServerAddress(raftAddr), 0, 0)
+	}
 	if err := f.Error(); err != nil {
 		return err
 	}
@@ -101,6 +300,158 @@ func (s *Store) Join(nodeID, grpcAddr, raftAddr string) error {
 	return nil
 }
 
+//TransferLeadership hands the leadership over to the voter nodeID, or to
+//the most up to date voter if nodeID is empty. It returns once this node
+//stepped down.
+func (s *Store) TransferLeadership(nodeID string) error {
+	if s.raft.State() != raft.Leader {
+		return ErrNotLeader
+	}
+	if nodeID == "" {
+		return s.transferLeadership(s.raft.LeadershipTransfer)
+	}
+	if nodeID == s.RaftId {
+		return nil
+	}
+
+	srv, err := s.server(nodeID)
+	if err != nil {
+		return err
+	}
+	if srv.Suffrage != raft.Voter {
+		return fmt.Errorf("node %s is not a voter", nodeID)
+	}
+	s.logger.Printf("transferring leadership to node %s at %s", nodeID, srv.Address)
+	return s.transferLeadership(func() raft.Future {
+		return s.raft.LeadershipTransferToServer(srv.ID, srv.Address)
+	})
+}
+
+//RemoveNode removes nodeID from the cluster and deletes its meta entry.
+//The leader cannot remove itself, see TransferLeadership.
+func (s *Store) RemoveNode(nodeID string) error {
+	if s.raft.State() != raft.Leader {
+		return ErrNotLeader
+	}
+	if nodeID == s.RaftId {
+		return ErrRemoveLeader
+	}
+	if _, err := s.server(nodeID); err != nil {
+		return err
+	}
+
+	if err := s.raft.RemoveServer(raft.ServerID(nodeID), 0, 0).Error(); err != nil {
+		return err
+	}
+	if err := s.DeleteMeta(nodeID); err != nil {
+		return fmt.Errorf("node %s removed, but deleting its meta failed: %v", nodeID, err)
+	}
+	s.logger.Printf("node %s removed", nodeID)
+	return nil
+}
+
+//DemoteVoter turns the voter nodeID into a nonvoter. It keeps receiving the
+//log but no longer counts towards the quorum.
+func (s *Store) DemoteVoter(nodeID string) error {
+	if s.raft.State() != raft.Leader {
+		return ErrNotLeader
+	}
+	if nodeID == s.RaftId {
+		return ErrRemoveLeader
+	}
+	srv, err := s.server(nodeID)
+	if err != nil {
+		return err
+	}
+	if srv.Suffrage != raft.Voter {
+		return nil
+	}
+
+	if err := s.raft.DemoteVoter(srv.ID, 0, 0).Error(); err != nil {
+		return err
+	}
+	s.logger.Printf("node %s demoted to nonvoter", nodeID)
+	return nil
+}
+
+//Promote turns the nonvoter nodeID into a voter. applied is the index the
+//node applied up to, it must be within promoteMaxLag entries of the leader
+//log so that the new voter does not hold back commits while catching up.
+func (s *Store) Promote(nodeID string, applied uint64) error {
+	if s.raft.State() != raft.Leader {
+		return ErrNotLeader
+	}
+	srv, err := s.server(nodeID)
+	if err != nil {
+		return err
+	}
+	if srv.Suffrage == raft.Voter {
+		return nil
+	}
+	if last := s.raft.LastIndex(); last > applied && last-applied > promoteMaxLag {
+		return fmt.Errorf("%w: node %s is %d entries behind", ErrNotCaughtUp, nodeID, last-applied)
+	}
+
+	if err := s.raft.AddVoter(srv.ID, srv.Address, 0, 0).Error(); err != nil {
+		return err
+	}
+	s.logger.Printf("node %s promoted to voter", nodeID)
+	return nil
+}
+
+//Status returns the raft progress of this node
+func (s *Store) Status() NodeStatus {
+	return NodeStatus{
+		ID:           s.RaftId,
+		State:        strings.ToLower(s.raft.State().String()),
+		AppliedIndex: s.raft.AppliedIndex(),
+		LastLogIndex: s.raft.LastIndex(),
+	}
+}
+
+//Members returns the servers of the raft configuration. It is served by
+//the leader, whose configuration is the latest one.
+func (s *Store) Members() ([]Member, error) {
+	if s.raft.State() != raft.Leader {
+		return nil, ErrNotLeader
+	}
+	configuration := s.raft.GetConfiguration()
+	if err := configuration.Error(); err != nil {
+		return nil, err
+	}
+
+	leader := s.raft.Leader()
+	var members []Member
+	for _, srv := range configuration.Configuration().Servers {
+		grpcAddr, err := s.GetMeta(string(srv.ID))
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, Member{
+			ID:       string(srv.ID),
+			RaftAddr: string(srv.Address),
+			GrpcAddr: grpcAddr,
+			Suffrage: strings.ToLower(srv.Suffrage.String()),
+			Leader:   srv.Address == leader,
+		})
+	}
+	return members, nil
+}
+
+// server returns nodeID from the current raft configuration.
+func (s *Store) server(nodeID string) (raft.Server, error) {
+	configuration := s.raft.GetConfiguration()
+	if err := configuration.Error(); err != nil {
+		return raft.Server{}, err
+	}
+	for _, srv := range configuration.Configuration().Servers {
+		if srv.ID == raft.ServerID(nodeID) {
+			return srv, nil
+		}
+	}
+	return raft.Server{}, ErrNodeNotFound
+}
+
 //LeaderAPIAddr returns the leader address of the raft cluster
 func (s *Store) LeaderAPIAddr() string {
 	id, err := s.LeaderID()
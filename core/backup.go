@@ -0,0 +1,169 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/raft"
+	boltdb "github.com/hashicorp/raft-boltdb"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// A backup is a raft snapshot behind a header recording where it belongs in
+// the log:
+//
+//	magic version index term size snapshot
+//
+// index, term and size are 8 byte big endian integers. The snapshot itself
+// is in the fsm snapshot format and carries its own checksum.
+const (
+	backupMagic     = "RGBK"
+	backupFormatV1  = byte(0x01)
+	backupHeaderLen = len(backupMagic) + 1 + 3*8
+)
+
+var (
+	// ErrInvalidBackup is returned when a backup file is not recognized.
+	ErrInvalidBackup = errors.New("not a backup file")
+
+	// ErrDataDirInUse is returned when restoring a backup into a data dir
+	// that already holds raft state.
+	ErrDataDirInUse = errors.New("data dir already holds raft state")
+
+	// keyCurrentTerm is the key raft keeps its current term under in the
+	// stable store.
+	keyCurrentTerm = []byte("CurrentTerm")
+)
+
+//Backup writes a point-in-time snapshot of the store to w. It takes a
+//snapshot first, or uses the latest one if nothing was applied since.
+func (s *Store) Backup(w io.Writer) error {
+	if s.raft.State() != raft.Leader {
+		return ErrNotLeader
+	}
+
+	var (
+		meta *raft.SnapshotMeta
+		rc   io.ReadCloser
+	)
+	f := s.raft.Snapshot()
+	switch err := f.Error(); err {
+	case nil:
+		if meta, rc, err = f.Open(); err != nil {
+			return err
+		}
+	case raft.ErrNothingNewToSnapshot:
+		snaps, err := s.snapshots.List()
+		if err != nil {
+			return err
+		}
+		if len(snaps) == 0 {
+			return raft.ErrNothingNewToSnapshot
+		}
+		if meta, rc, err = s.snapshots.Open(snaps[0].ID); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+	defer rc.Close()
+
+	header := make([]byte, 0, backupHeaderLen)
+	header = append(header, backupMagic...)
+	header = append(header, backupFormatV1)
+	header = appendUint64(header, meta.Index)
+	header = appendUint64(header, meta.Term)
+	header = appendUint64(header, uint64(meta.Size))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(w, rc, meta.Size); err != nil {
+		return fmt.Errorf("copy snapshot %s: %v", meta.ID, err)
+	}
+	s.logger.Printf("backed up snapshot %s at index %d", meta.ID, meta.Index)
+	return nil
+}
+
+//RestoreBackup seeds the empty data dir with the backup read from r, for a
+//single node cluster made of nodeID at raftAddr. Starting the node then
+//restores the backup and elects it leader. It returns the raft index of the
+//backup.
+func RestoreBackup(r io.Reader, dataDir, nodeID, raftAddr string) (uint64, error) {
+	for _, name := range []string{"logs.dat", "stable.dat", "fsm.dat", "snapshots"} {
+		if pathExists(filepath.Join(dataDir, name)) {
+			return 0, ErrDataDirInUse
+		}
+	}
+
+	br := bufio.NewReader(r)
+	header := make([]byte, backupHeaderLen)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return 0, fmt.Errorf("read backup header: %v", noEOF(err))
+	}
+	if !bytes.Equal(header[:len(backupMagic)], []byte(backupMagic)) {
+		return 0, ErrInvalidBackup
+	}
+	if v := header[len(backupMagic)]; v != backupFormatV1 {
+		return 0, fmt.Errorf("unknown backup format: 0x%02x", v)
+	}
+	fields := header[len(backupMagic)+1:]
+	index := binary.BigEndian.Uint64(fields[0:8])
+	term := binary.BigEndian.Uint64(fields[8:16])
+	size := int64(binary.BigEndian.Uint64(fields[16:24]))
+
+	fss, err := raft.NewFileSnapshotStore(dataDir, retainSnapshotCount, os.Stderr)
+	if err != nil {
+		return 0, fmt.Errorf(`raft.NewFileSnapshotStore(%q, ...): %v`, dataDir, err)
+	}
+	cfg := raft.Configuration{
+		Servers: []raft.Server{
+			{
+				Suffrage: raft.Voter,
+				ID:       raft.ServerID(nodeID),
+				Address:  raft.ServerAddress(raftAddr),
+			},
+		},
+	}
+	// The transport only encodes the legacy peer list of the snapshot,
+	// which is the raw address for every transport of this package.
+	_, trans := raft.NewInmemTransport(raft.ServerAddress(raftAddr))
+	defer trans.Close()
+	sink, err := fss.Create(raft.SnapshotVersionMax, index, term, cfg, index, trans)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := io.CopyN(sink, br, size); err != nil {
+		sink.Cancel()
+		return 0, fmt.Errorf("copy snapshot: %v", noEOF(err))
+	}
+	if err := sink.Close(); err != nil {
+		return 0, err
+	}
+
+	// raft refuses a snapshot from a term later than its current one. The
+	// log store is created as well so that StartRaft sees an existing node
+	// and does not try to bootstrap it.
+	stabledb, err := boltdb.NewBoltStore(filepath.Join(dataDir, "stable.dat"))
+	if err != nil {
+		return 0, fmt.Errorf("boltdb.NewBoltStore(%q): %v", filepath.Join(dataDir, "stable.dat"), err)
+	}
+	defer stabledb.Close()
+	if err := stabledb.SetUint64(keyCurrentTerm, term); err != nil {
+		return 0, err
+	}
+	logdb, err := boltdb.NewBoltStore(filepath.Join(dataDir, "logs.dat"))
+	if err != nil {
+		return 0, fmt.Errorf("boltdb.NewBoltStore(%q): %v", filepath.Join(dataDir, "logs.dat"), err)
+	}
+	return index, logdb.Close()
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	rpcservicepb "raft-grpc-demo/proto"
+)
+
+func TestRestoreBackup(t *testing.T) {
+	f := (*fsm)(NewStore())
+	applyTo(t, f, 7, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1"})
+	snap := persist(t, f)
+
+	var backup bytes.Buffer
+	backup.WriteString(backupMagic)
+	backup.WriteByte(backupFormatV1)
+	backup.Write(appendUint64(nil, 7))
+	backup.Write(appendUint64(nil, 3))
+	backup.Write(appendUint64(nil, uint64(len(snap))))
+	backup.Write(snap)
+
+	dir, err := ioutil.TempDir("", "backup")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("seeds the data dir", func(t *testing.T) {
+		index, err := RestoreBackup(bytes.NewReader(backup.Bytes()), dir, "n1", "127.0.0.1:52000")
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(7), index)
+
+		fss, err := raft.NewFileSnapshotStore(dir, retainSnapshotCount, ioutil.Discard)
+		assert.Nil(t, err)
+		snaps, err := fss.List()
+		assert.Nil(t, err)
+		assert.Len(t, snaps, 1)
+		assert.Equal(t, uint64(7), snaps[0].Index)
+		assert.Equal(t, uint64(3), snaps[0].Term)
+		assert.Equal(t, raft.ServerID("n1"), snaps[0].Configuration.Servers[0].ID)
+
+		_, rc, err := fss.Open(snaps[0].ID)
+		assert.Nil(t, err)
+		defer rc.Close()
+		restored := (*fsm)(NewStore())
+		assert.Nil(t, restored.Restore(rc))
+		assert.Equal(t, "1", valueOf(t, restored, "a"))
+	})
+
+	t.Run("refuses a used data dir", func(t *testing.T) {
+		_, err := RestoreBackup(bytes.NewReader(backup.Bytes()), dir, "n1", "127.0.0.1:52000")
+		assert.Equal(t, ErrDataDirInUse, err)
+	})
+
+	t.Run("refuses other files", func(t *testing.T) {
+		other, err := ioutil.TempDir("", "backup")
+		assert.Nil(t, err)
+		defer os.RemoveAll(other)
+
+		_, err = RestoreBackup(bytes.NewReader(snap), other, "n1", "127.0.0.1:52000")
+		assert.NotNil(t, err)
+		_, err = RestoreBackup(bytes.NewReader(backup.Bytes()[:backup.Len()-1]), other, "n1", "127.0.0.1:52000")
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	rpcservicepb "raft-grpc-demo/proto"
+)
+
+const (
+	// commandFormatV1 marks a log entry holding a protobuf encoded
+	// rpcservicepb.Command.
+	commandFormatV1 byte = 0x01
+
+	// legacyJSONPrefix is the first byte of log entries written before the
+	// protobuf envelope was introduced.
+	legacyJSONPrefix byte = '{'
+)
+
+var (
+	// ErrEmptyCommand is returned when a log entry carries no data.
+	ErrEmptyCommand = errors.New("empty command")
+
+	// ErrUnknownCommandFormat is returned when a log entry starts with a
+	// format version byte this node does not understand.
+	ErrUnknownCommandFormat = errors.New("unknown command format")
+)
+
+// legacyCommand is the JSON command layout used by older releases. It is
+// only decoded, never written, so that existing logs can still be replayed.
+type legacyCommand struct {
+	Op    string `json:"op,omitempty"`
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// encodeCommand serializes c prefixed with the current format version byte.
+func encodeCommand(c *rpcservicepb.Command) ([]byte, error) {
+	b := make([]byte, 1+c.Size())
+	b[0] = commandFormatV1
+	if _, err := c.MarshalTo(b[1:]); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// decodeCommand parses a raft log entry written by encodeCommand or by a
+// release still using the JSON command format.
+func decodeCommand(b []byte) (*rpcservicepb.Command, error) {
+	if len(b) == 0 {
+		return nil, ErrEmptyCommand
+	}
+
+	switch b[0] {
+	case commandFormatV1:
+		c := &rpcservicepb.Command{}
+		if err := c.Unmarshal(b[1:]); err != nil {
+			return nil, err
+		}
+		return c, nil
+	case legacyJSONPrefix:
+		return decodeLegacyCommand(b)
+	default:
+		return nil, fmt.Errorf("%w: 0x%02x", ErrUnknownCommandFormat, b[0])
+	}
+}
+
+func decodeLegacyCommand(b []byte) (*rpcservicepb.Command, error) {
+	var lc legacyCommand
+	if err := json.Unmarshal(b, &lc); err != nil {
+		return nil, err
+	}
+
+	c := &rpcservicepb.Command{Key: lc.Key, Value: lc.Value}
+	switch lc.Op {
+	case "set":
+		c.Op = rpcservicepb.CommandOp_CMD_SET
+	case "delete":
+		c.Op = rpcservicepb.CommandOp_CMD_DELETE
+	default:
+		return nil, fmt.Errorf("unrecognized legacy command op: %s", lc.Op)
+	}
+	return c, nil
+}
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rpcservicepb "raft-grpc-demo/proto"
+)
+
+func TestCommandCodec(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		c := &rpcservicepb.Command{
+			Op:       rpcservicepb.CommandOp_CMD_SET,
+			Key:      "k",
+			Value:    "v",
+			Metadata: map[string]string{"origin": "test"},
+		}
+		b, err := encodeCommand(c)
+		assert.Nil(t, err)
+		assert.Equal(t, commandFormatV1, b[0])
+
+		got, err := decodeCommand(b)
+		assert.Nil(t, err)
+		assert.Equal(t, c, got)
+	})
+
+	t.Run("legacy json", func(t *testing.T) {
+		got, err := decodeCommand([]byte(`{"op":"set","key":"k","value":"v"}`))
+		assert.Nil(t, err)
+		assert.Equal(t, rpcservicepb.CommandOp_CMD_SET, got.Op)
+		assert.Equal(t, "k", got.Key)
+		assert.Equal(t, "v", got.Value)
+
+		got, err = decodeCommand([]byte(`{"op":"delete","key":"k"}`))
+		assert.Nil(t, err)
+		assert.Equal(t, rpcservicepb.CommandOp_CMD_DELETE, got.Op)
+
+		_, err = decodeCommand([]byte(`{"op":"noop"}`))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := decodeCommand([]byte{0x7f, 0x00})
+		assert.ErrorIs(t, err, ErrUnknownCommandFormat)
+
+		_, err = decodeCommand(nil)
+		assert.Equal(t, ErrEmptyCommand, err)
+	})
+}
@@ -0,0 +1,219 @@
+package core
+
+const (
+	// EngineMemory keeps the state machine in memory. It is rebuilt from the
+	// latest snapshot and the raft log every time the node starts.
+	EngineMemory = "memory"
+
+	// EngineBolt keeps the state machine on disk in RaftDataDir. Entries
+	// already applied before a restart are not applied again.
+	EngineBolt = "bolt"
+)
+
+// engine stores the state of the fsm. Reads are guarded by Store.mutex like
+// every other access to the fsm state.
+type engine interface {
+	get(k string) (kvEntry, bool, error)
+	// ascend calls fn for the keys in [start, end) in order until it returns
+	// false. An empty end means no upper bound.
+	ascend(start, end string, fn func(k string, e kvEntry) bool) error
+	// leases returns the ttl in seconds of every lease
+	leases() (map[int64]int64, error)
+	// getMeta reads k from the cluster metadata, which is kept apart from
+	// the user keys
+	getMeta(k string) (string, bool, error)
+	// appliedIndex returns the raft index the stored state reflects, 0 if
+	// the engine does not survive restarts or the index is unknown.
+	appliedIndex() (uint64, error)
+	// begin starts the transaction holding the writes of one batch of log
+	// entries, see fsm.ApplyBatch. Only one transaction is open at a time.
+	begin() (engineTxn, error)
+	// snapshot captures the current state. Writes made after it returns are
+	// not visible in the snapshot.
+	snapshot() (engineSnapshot, error)
+	// reader captures the current keys like snapshot. It is read without
+	// Store.mutex, so that long scans do not hold up the fsm.
+	reader() (engineReader, error)
+	close() error
+}
+
+// engineTxn buffers the writes of one batch of log entries. The first error is kept
+// and returned by commit, every later call is then ignored.
+type engineTxn interface {
+	get(k string) (kvEntry, bool)
+	put(k string, e kvEntry)
+	delete(k string)
+	putLease(id, ttl int64)
+	deleteLease(id int64)
+	putMeta(k, v string)
+	deleteMeta(k string)
+	// clear removes every key, lease and metadata entry
+	clear()
+	// commit makes the writes durable together with the raft index they
+	// bring the state to.
+	commit(index uint64) error
+	// rollback discards the writes instead of committing them
+	rollback()
+}
+
+// engineReader is a point in time view of the keys of an engine
+type engineReader interface {
+	// ascend calls fn for the keys in [start, end) in order until it returns
+	// false. An empty end means no upper bound.
+	ascend(start, end string, fn func(k string, e kvEntry) bool) error
+	release()
+}
+
+// engineSnapshot is a point in time view of an engine
+type engineSnapshot interface {
+	engineReader
+	leases() (map[int64]int64, error)
+	metadata() (map[string]string, error)
+}
+
+// memoryEngine is the in-memory engine, keys are held in a kvIndex.
+type memoryEngine struct {
+	kv   *kvIndex
+	ttls map[int64]int64
+	meta map[string]string
+}
+
+func newMemoryEngine() *memoryEngine {
+	return &memoryEngine{kv: newKVIndex(), ttls: make(map[int64]int64), meta: make(map[string]string)}
+}
+
+func (m *memoryEngine) get(k string) (kvEntry, bool, error) {
+	e, ok := m.kv.get(k)
+	return e, ok, nil
+}
+
+func (m *memoryEngine) ascend(start, end string, fn func(k string, e kvEntry) bool) error {
+	m.kv.ascend(start, end, fn)
+	return nil
+}
+
+func (m *memoryEngine) leases() (map[int64]int64, error) {
+	return copyTTLs(m.ttls), nil
+}
+
+func (m *memoryEngine) getMeta(k string) (string, bool, error) {
+	v, ok := m.meta[k]
+	return v, ok, nil
+}
+
+func (m *memoryEngine) appliedIndex() (uint64, error) {
+	return 0, nil
+}
+
+// begin returns a transaction writing straight to the index since commit
+// can never fail.
+func (m *memoryEngine) begin() (engineTxn, error) {
+	return &memoryTxn{m: m}, nil
+}
+
+func (m *memoryEngine) snapshot() (engineSnapshot, error) {
+	// Cloning the index is cheap, the keys are only copied by Persist.
+	return &memorySnapshot{kv: m.kv.clone(), ttls: copyTTLs(m.ttls), meta: copyMeta(m.meta)}, nil
+}
+
+func (m *memoryEngine) reader() (engineReader, error) {
+	return &memorySnapshot{kv: m.kv.clone()}, nil
+}
+
+func (m *memoryEngine) close() error {
+	return nil
+}
+
+// memoryTxn writes to the engine as it goes. Only a transaction starting
+// with clear, as used by Restore, can be rolled back: clear keeps the
+// previous state aside until the transaction ends.
+type memoryTxn struct {
+	m      *memoryEngine
+	backup *memoryEngine // state before clear, nil if not cleared
+}
+
+func (t *memoryTxn) get(k string) (kvEntry, bool) {
+	return t.m.kv.get(k)
+}
+
+func (t *memoryTxn) put(k string, e kvEntry) {
+	t.m.kv.set(k, e)
+}
+
+func (t *memoryTxn) delete(k string) {
+	t.m.kv.delete(k)
+}
+
+func (t *memoryTxn) putLease(id, ttl int64) {
+	t.m.ttls[id] = ttl
+}
+
+func (t *memoryTxn) deleteLease(id int64) {
+	delete(t.m.ttls, id)
+}
+
+func (t *memoryTxn) putMeta(k, v string) {
+	t.m.meta[k] = v
+}
+
+func (t *memoryTxn) deleteMeta(k string) {
+	delete(t.m.meta, k)
+}
+
+func (t *memoryTxn) clear() {
+	if t.backup == nil {
+		t.backup = &memoryEngine{kv: t.m.kv, ttls: t.m.ttls, meta: t.m.meta}
+	}
+	t.m.kv = newKVIndex()
+	t.m.ttls = make(map[int64]int64)
+	t.m.meta = make(map[string]string)
+}
+
+func (t *memoryTxn) commit(uint64) error {
+	t.backup = nil
+	return nil
+}
+
+func (t *memoryTxn) rollback() {
+	if t.backup != nil {
+		t.m.kv, t.m.ttls, t.m.meta = t.backup.kv, t.backup.ttls, t.backup.meta
+		t.backup = nil
+	}
+}
+
+type memorySnapshot struct {
+	kv   *kvIndex
+	ttls map[int64]int64
+	meta map[string]string
+}
+
+func (m *memorySnapshot) ascend(start, end string, fn func(k string, e kvEntry) bool) error {
+	m.kv.ascend(start, end, fn)
+	return nil
+}
+
+func (m *memorySnapshot) leases() (map[int64]int64, error) {
+	return m.ttls, nil
+}
+
+func (m *memorySnapshot) metadata() (map[string]string, error) {
+	return m.meta, nil
+}
+
+func (m *memorySnapshot) release() {}
+
+func copyMeta(meta map[string]string) map[string]string {
+	c := make(map[string]string, len(meta))
+	for k, v := range meta {
+		c[k] = v
+	}
+	return c
+}
+
+func copyTTLs(ttls map[int64]int64) map[int64]int64 {
+	c := make(map[int64]int64, len(ttls))
+	for id, ttl := range ttls {
+		c[id] = ttl
+	}
+	return c
+}
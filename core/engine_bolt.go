@@ -0,0 +1,294 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	bucketKeys   = []byte("keys")
+	bucketLeases = []byte("leases")
+	bucketSystem = []byte("system") // cluster metadata, see Store.SetMeta
+	bucketMeta   = []byte("meta")   // bookkeeping of the engine itself
+
+	metaAppliedIndex = []byte("applied_index")
+)
+
+// boltEngine stores the state machine in a bolt database. Every log entry
+// is written in one bolt transaction together with its index, so the
+// database always reflects a prefix of the log.
+type boltEngine struct {
+	db *bolt.DB
+}
+
+func openBoltEngine(path string) (*boltEngine, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{bucketKeys, bucketLeases, bucketSystem, bucketMeta} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &boltEngine{db: db}, nil
+}
+
+func (b *boltEngine) get(k string) (kvEntry, bool, error) {
+	var (
+		e  kvEntry
+		ok bool
+	)
+	err := b.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(bucketKeys).Get(boltKey(k))
+		if v == nil {
+			return nil
+		}
+		ok = true
+		return json.Unmarshal(v, &e)
+	})
+	return e, ok, err
+}
+
+func (b *boltEngine) ascend(start, end string, fn func(k string, e kvEntry) bool) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return ascendBucket(tx.Bucket(bucketKeys), start, end, fn)
+	})
+}
+
+func (b *boltEngine) leases() (map[int64]int64, error) {
+	var ttls map[int64]int64
+	err := b.db.View(func(tx *bolt.Tx) error {
+		ttls = readLeases(tx.Bucket(bucketLeases))
+		return nil
+	})
+	return ttls, err
+}
+
+func (b *boltEngine) getMeta(k string) (string, bool, error) {
+	var (
+		v  string
+		ok bool
+	)
+	err := b.db.View(func(tx *bolt.Tx) error {
+		if raw := tx.Bucket(bucketSystem).Get(boltKey(k)); raw != nil {
+			v, ok = string(raw), true
+		}
+		return nil
+	})
+	return v, ok, err
+}
+
+func (b *boltEngine) appliedIndex() (uint64, error) {
+	var index uint64
+	err := b.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(bucketMeta).Get(metaAppliedIndex); v != nil {
+			index = binary.BigEndian.Uint64(v)
+		}
+		return nil
+	})
+	return index, err
+}
+
+func (b *boltEngine) begin() (engineTxn, error) {
+	tx, err := b.db.Begin(true)
+	if err != nil {
+		return nil, err
+	}
+	return &boltTxn{tx: tx}, nil
+}
+
+// snapshot holds a read transaction open until the snapshot is released.
+// bolt cannot grow its memory map while a read transaction is open, so
+// writes may stall for the time Persist takes if the database must grow.
+func (b *boltEngine) snapshot() (engineSnapshot, error) {
+	tx, err := b.db.Begin(false)
+	if err != nil {
+		return nil, err
+	}
+	return &boltSnapshot{tx: tx}, nil
+}
+
+func (b *boltEngine) reader() (engineReader, error) {
+	return b.snapshot()
+}
+
+func (b *boltEngine) close() error {
+	return b.db.Close()
+}
+
+type boltTxn struct {
+	tx  *bolt.Tx
+	err error
+}
+
+func (t *boltTxn) get(k string) (kvEntry, bool) {
+	var e kvEntry
+	if t.err != nil {
+		return e, false
+	}
+	v := t.tx.Bucket(bucketKeys).Get(boltKey(k))
+	if v == nil {
+		return e, false
+	}
+	if err := json.Unmarshal(v, &e); err != nil {
+		t.err = err
+		return e, false
+	}
+	return e, true
+}
+
+func (t *boltTxn) put(k string, e kvEntry) {
+	if t.err != nil {
+		return
+	}
+	v, err := json.Marshal(e)
+	if err != nil {
+		t.err = err
+		return
+	}
+	t.err = t.tx.Bucket(bucketKeys).Put(boltKey(k), v)
+}
+
+func (t *boltTxn) delete(k string) {
+	if t.err != nil {
+		return
+	}
+	t.err = t.tx.Bucket(bucketKeys).Delete(boltKey(k))
+}
+
+func (t *boltTxn) putLease(id, ttl int64) {
+	if t.err != nil {
+		return
+	}
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, uint64(ttl))
+	t.err = t.tx.Bucket(bucketLeases).Put(leaseKey(id), v)
+}
+
+func (t *boltTxn) deleteLease(id int64) {
+	if t.err != nil {
+		return
+	}
+	t.err = t.tx.Bucket(bucketLeases).Delete(leaseKey(id))
+}
+
+func (t *boltTxn) putMeta(k, v string) {
+	if t.err != nil {
+		return
+	}
+	t.err = t.tx.Bucket(bucketSystem).Put(boltKey(k), []byte(v))
+}
+
+func (t *boltTxn) deleteMeta(k string) {
+	if t.err != nil {
+		return
+	}
+	t.err = t.tx.Bucket(bucketSystem).Delete(boltKey(k))
+}
+
+func (t *boltTxn) clear() {
+	for _, name := range [][]byte{bucketKeys, bucketLeases, bucketSystem} {
+		if t.err != nil {
+			return
+		}
+		if t.err = t.tx.DeleteBucket(name); t.err != nil {
+			return
+		}
+		_, t.err = t.tx.CreateBucket(name)
+	}
+}
+
+func (t *boltTxn) commit(index uint64) error {
+	if t.err != nil {
+		t.tx.Rollback()
+		return t.err
+	}
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, index)
+	if err := t.tx.Bucket(bucketMeta).Put(metaAppliedIndex, v); err != nil {
+		t.tx.Rollback()
+		return err
+	}
+	return t.tx.Commit()
+}
+
+func (t *boltTxn) rollback() {
+	t.tx.Rollback()
+}
+
+type boltSnapshot struct {
+	tx *bolt.Tx
+}
+
+func (s *boltSnapshot) ascend(start, end string, fn func(k string, e kvEntry) bool) error {
+	return ascendBucket(s.tx.Bucket(bucketKeys), start, end, fn)
+}
+
+func (s *boltSnapshot) leases() (map[int64]int64, error) {
+	return readLeases(s.tx.Bucket(bucketLeases)), nil
+}
+
+func (s *boltSnapshot) metadata() (map[string]string, error) {
+	meta := make(map[string]string)
+	err := s.tx.Bucket(bucketSystem).ForEach(func(k, v []byte) error {
+		meta[string(k[1:])] = string(v)
+		return nil
+	})
+	return meta, err
+}
+
+func (s *boltSnapshot) release() {
+	s.tx.Rollback()
+}
+
+// ascendBucket calls fn for the keys of bk in [start, end). bolt orders keys
+// bytewise, which matches the string order of kvIndex.
+func ascendBucket(bk *bolt.Bucket, start, end string, fn func(k string, e kvEntry) bool) error {
+	c := bk.Cursor()
+	for k, v := c.Seek(boltKey(start)); k != nil; k, v = c.Next() {
+		if end != "" && bytes.Compare(k, boltKey(end)) >= 0 {
+			return nil
+		}
+		var e kvEntry
+		if err := json.Unmarshal(v, &e); err != nil {
+			return fmt.Errorf("corrupt entry for key %q: %v", k[1:], err)
+		}
+		if !fn(string(k[1:]), e) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func readLeases(bk *bolt.Bucket) map[int64]int64 {
+	ttls := make(map[int64]int64)
+	bk.ForEach(func(k, v []byte) error {
+		ttls[int64(binary.BigEndian.Uint64(k))] = int64(binary.BigEndian.Uint64(v))
+		return nil
+	})
+	return ttls
+}
+
+// boltKey returns the bolt key of k. bolt rejects empty keys, which are
+// valid in the store, so every key is stored behind a one byte prefix.
+func boltKey(k string) []byte {
+	return append([]byte{'k'}, k...)
+}
+
+func leaseKey(id int64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(id))
+	return k
+}
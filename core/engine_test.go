@@ -0,0 +1,145 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	rpcservicepb "raft-grpc-demo/proto"
+)
+
+// newBoltFsm returns an fsm backed by a bolt engine stored in dir.
+func newBoltFsm(t *testing.T, dir string) *fsm {
+	e, err := openBoltEngine(filepath.Join(dir, "fsm.dat"))
+	assert.Nil(t, err)
+	s := NewStore()
+	s.engine = e
+	assert.Nil(t, (*fsm)(s).loadLeases())
+	return (*fsm)(s)
+}
+
+func TestBoltEngine(t *testing.T) {
+	dir, err := ioutil.TempDir("", "engine")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	f := newBoltFsm(t, dir)
+	applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_LEASE_GRANT, Ttl: 10})
+	applyTo(t, f, 2, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1", Lease: 1})
+	applyTo(t, f, 3, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "", Value: "empty"})
+	applyTo(t, f, 4, &rpcservicepb.Command{
+		Op: rpcservicepb.CommandOp_CMD_BATCH,
+		Ops: []*rpcservicepb.Command{
+			{Op: rpcservicepb.CommandOp_CMD_SET, Key: "b", Value: "2"},
+			{Op: rpcservicepb.CommandOp_CMD_SET, Key: "c", Value: "3"},
+			{Op: rpcservicepb.CommandOp_CMD_DELETE, Key: "c"},
+		},
+	})
+
+	rr, err := (*Store)(f).Range(RangeOptions{CountOnly: true}, Stale)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, rr.Count)
+	assert.Equal(t, "empty", valueOf(t, f, ""))
+	assert.Nil(t, f.engine.close())
+
+	t.Run("survives restarts", func(t *testing.T) {
+		f := newBoltFsm(t, dir)
+		defer f.engine.close()
+
+		applied, err := f.engine.appliedIndex()
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(4), applied)
+		assert.Equal(t, &KeyValue{Key: "a", Value: "1", CreateRevision: 2, ModRevision: 2, Version: 1, Lease: 1}, getFrom(t, f, "a"))
+		assert.Nil(t, getFrom(t, f, "c"))
+
+		// Keys attached to the lease are found again.
+		rsp := applyTo(t, f, 5, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_LEASE_REVOKE, Lease: 1})
+		assert.True(t, rsp.(*applyResponse).succeeded)
+		assert.Nil(t, getFrom(t, f, "a"))
+	})
+
+	t.Run("skips recovered entries", func(t *testing.T) {
+		f := newBoltFsm(t, dir)
+		defer f.engine.close()
+		f.recoveredIndex = 5
+
+		assert.Nil(t, applyTo(t, f, 2, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1"}))
+		assert.Nil(t, getFrom(t, f, "a"))
+	})
+
+	t.Run("snapshot round trip", func(t *testing.T) {
+		f := newBoltFsm(t, dir)
+		defer f.engine.close()
+
+		snap, err := f.Snapshot()
+		assert.Nil(t, err)
+		store := raft.NewInmemSnapshotStore()
+		sink, err := store.Create(raft.SnapshotVersionMax, 5, 1, raft.Configuration{}, 1, nil)
+		assert.Nil(t, err)
+		assert.Nil(t, snap.Persist(sink))
+		snap.Release()
+
+		restoreDir, err := ioutil.TempDir("", "engine")
+		assert.Nil(t, err)
+		defer os.RemoveAll(restoreDir)
+		restored := newBoltFsm(t, restoreDir)
+		defer restored.engine.close()
+		applyTo(t, restored, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "stale", Value: "x"})
+
+		_, rc, err := store.Open(sink.ID())
+		assert.Nil(t, err)
+		assert.Nil(t, restored.Restore(rc))
+		assert.Equal(t, getFrom(t, f, "b"), getFrom(t, restored, "b"))
+		assert.Equal(t, "empty", valueOf(t, restored, ""))
+		assert.Nil(t, getFrom(t, restored, "stale"))
+
+		applied, err := restored.engine.appliedIndex()
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(0), applied)
+	})
+}
+
+func TestBoltEngineApplyBatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "engine")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	f := newBoltFsm(t, dir)
+	defer f.engine.close()
+	w, err := (*Store)(f).Watch("", true, 0)
+	assert.Nil(t, err)
+	defer w.Cancel()
+
+	log := func(index uint64, c *rpcservicepb.Command) *raft.Log {
+		b, err := encodeCommand(c)
+		assert.Nil(t, err)
+		return &raft.Log{Index: index, Type: raft.LogCommand, Data: b}
+	}
+	resps := f.ApplyBatch([]*raft.Log{
+		log(1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1"}),
+		{Index: 2, Type: raft.LogConfiguration},
+		log(3, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "b", Value: "2", Lease: 42}),
+		log(4, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "3", PrevRevision: 1}),
+	})
+	assert.Equal(t, []interface{}{
+		&applyResponse{succeeded: true, revision: 1},
+		nil,
+		ErrLeaseNotFound,
+		&applyResponse{succeeded: true, revision: 4},
+	}, resps)
+
+	// One transaction stored the whole batch.
+	applied, err := f.engine.appliedIndex()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), applied)
+	assert.Equal(t, &KeyValue{Key: "a", Value: "3", CreateRevision: 1, ModRevision: 4, Version: 2}, getFrom(t, f, "a"))
+
+	// Every entry is published at its own revision.
+	for _, rev := range []uint64{1, 4} {
+		ev := <-w.Events()
+		assert.Equal(t, rev, ev.Revision)
+	}
+}
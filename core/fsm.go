@@ -1,43 +1,239 @@
 package core
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/raft"
 	"io"
+	rpcservicepb "raft-grpc-demo/proto"
 )
 
 type fsm Store
 
+var _ raft.BatchingFSM = (*fsm)(nil)
+
+// kvEntry is the value stored for every key together with its revisions.
+// Revisions are raft log indexes, so they are identical on every replica.
+type kvEntry struct {
+	Value          string `json:"value"`
+	CreateRevision uint64 `json:"create_revision"`
+	ModRevision    uint64 `json:"mod_revision"`
+	Version        uint64 `json:"version"`
+	Lease          int64  `json:"lease,omitempty"`
+}
+
+// applyResponse is what fsm.Apply returns for writes. It reaches the
+// proposer through ApplyFuture.Response.
+type applyResponse struct {
+	succeeded bool
+	revision  uint64
+	results   []OpResult //results of the ops of a transaction
+}
+
 func (f *fsm) Apply(l *raft.Log) interface{} {
-	var c command
-	if err := json.Unmarshal(l.Data, &c); err != nil {
-		panic(fmt.Sprintf("failed to unmarshal command: %s", err.Error()))
+	return f.ApplyBatch([]*raft.Log{l})[0]
+}
+
+// ApplyBatch applies the entries raft committed together in one engine
+// transaction, so that the bolt engine syncs once per batch rather than once
+// per entry. The engine records the last index of the batch as applied.
+func (f *fsm) ApplyBatch(logs []*raft.Log) []interface{} {
+	resps := make([]interface{}, len(logs))
+	// The engine already holds the entries from before the restart.
+	first := 0
+	for first < len(logs) && logs[first].Index <= f.recoveredIndex {
+		first++
+	}
+	if first == len(logs) {
+		return resps
+	}
+	last := logs[len(logs)-1].Index
+
+	f.mutex.Lock()
+	tx, err := f.engine.begin()
+	if err != nil {
+		f.mutex.Unlock()
+		panic(fmt.Sprintf("failed to begin engine transaction at index %d: %v", logs[first].Index, err))
+	}
+	f.tx = tx
+	events := make([][]Event, len(logs))
+	for i := first; i < len(logs); i++ {
+		// Configuration changes reach the fsm as well, they change no key.
+		if logs[i].Type != raft.LogCommand {
+			continue
+		}
+		resps[i] = f.apply(logs[i])
+		events[i] = f.events
+		f.events = nil
+	}
+	err = tx.commit(last)
+	f.tx = nil
+	f.mutex.Unlock()
+
+	// The replicas would diverge if these entries were skipped, so a node
+	// that cannot store them must stop.
+	if err != nil {
+		panic(fmt.Sprintf("failed to commit indexes %d to %d to the engine: %v", logs[first].Index, last, err))
+	}
+
+	// Hand the changes to the watchers once the mutex is released. Batches
+	// are never applied concurrently, so events are still published in
+	// order.
+	for i := first; i < len(logs); i++ {
+		if logs[i].Type == raft.LogCommand {
+			f.watches.publish(logs[i].Index, events[i])
+		}
+	}
+
+	return resps
+}
+
+func (f *fsm) apply(l *raft.Log) interface{} {
+	c, err := decodeCommand(l.Data)
+	if err != nil {
+		// Every replica fails to decode the same entry, so skipping it keeps
+		// the state machines identical. The error is handed back to the
+		// proposer through ApplyFuture.Response.
+		f.logger.Printf("failed to decode command at index %d: %v", l.Index, err)
+		return err
 	}
 
 	switch c.Op {
-	case "set":
-		return f.applySet(c.Key, c.Value)
-	case "delete":
-		return f.applyDelete(c.Key)
+	case rpcservicepb.CommandOp_CMD_SET:
+		return f.applySet(l.Index, c)
+	case rpcservicepb.CommandOp_CMD_DELETE:
+		return f.applyDelete(l.Index, c.Key, c.PrevRevision)
+	case rpcservicepb.CommandOp_CMD_BATCH:
+		return f.applyBatch(l.Index, c.Ops)
+	case rpcservicepb.CommandOp_CMD_COMPARE_AND_SWAP:
+		return f.applyCompareAndSwap(l.Index, c.Key, c.Expected, c.Value)
+	case rpcservicepb.CommandOp_CMD_SET_IF_ABSENT:
+		return f.applySetIfAbsent(l.Index, c.Key, c.Value)
+	case rpcservicepb.CommandOp_CMD_DELETE_IF_VALUE:
+		return f.applyDeleteIfValue(l.Index, c.Key, c.Expected)
+	case rpcservicepb.CommandOp_CMD_LEASE_GRANT:
+		return f.applyLeaseGrant(l.Index, c.Ttl)
+	case rpcservicepb.CommandOp_CMD_LEASE_REVOKE:
+		return f.applyLeaseRevoke(l.Index, c.Lease)
+	case rpcservicepb.CommandOp_CMD_TXN:
+		return f.applyTxn(l.Index, c)
+	case rpcservicepb.CommandOp_CMD_META_SET:
+		return f.applyMetaSet(c.Key, c.Value)
+	case rpcservicepb.CommandOp_CMD_META_DELETE:
+		return f.applyMetaDelete(c.Key)
 	default:
-		panic(fmt.Sprintf("unrecognized command op: %s", c.Op))
+		return fmt.Errorf("unrecognized command op: %s", c.Op)
 	}
 }
 
-func (f *fsm) applySet(k, v string) interface{} {
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
+// put writes k at the given index, bumping its revisions and attaching it
+// to lease, or to no lease if it is zero. The lease must exist. The caller
+// must hold the mutex and run inside Apply.
+func (f *fsm) put(index uint64, k, v string, lease int64) {
+	e, ok := f.tx.get(k)
+	if !ok {
+		e = kvEntry{CreateRevision: index}
+	} else if e.Lease != lease {
+		f.detach(k, e.Lease)
+	}
+	e.Value = v
+	e.ModRevision = index
+	e.Version++
+	e.Lease = lease
+	f.tx.put(k, e)
+	if lease != 0 {
+		f.leases[lease].keys[k] = struct{}{}
+	}
+	f.events = append(f.events, Event{Type: EventPut, Key: k, Value: v, Revision: index})
+}
 
-	f.m[k] = v
-	return nil
+// remove deletes k at the given index and detaches it from its lease. The
+// caller must hold the mutex and run inside Apply.
+func (f *fsm) remove(index uint64, k string) {
+	if e, ok := f.tx.get(k); ok {
+		f.detach(k, e.Lease)
+		f.tx.delete(k)
+		f.events = append(f.events, Event{Type: EventDelete, Key: k, Revision: index})
+	}
 }
 
-func (f *fsm) applyDelete(k string) interface{} {
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
+// revisionMatches reports whether k was last modified at prevRevision. A zero
+// prevRevision means the write is unconditional. The caller must hold the
+// mutex and run inside Apply.
+func (f *fsm) revisionMatches(k string, prevRevision uint64) bool {
+	if prevRevision == 0 {
+		return true
+	}
+	e, ok := f.tx.get(k)
+	return ok && e.ModRevision == prevRevision
+}
+
+func (f *fsm) applySet(index uint64, c *rpcservicepb.Command) interface{} {
+	if !f.revisionMatches(c.Key, c.PrevRevision) {
+		return &applyResponse{succeeded: false}
+	}
+	lease := c.Lease
+	if c.Ttl > 0 {
+		// A ttl on the write grants a lease owned by this entry.
+		lease = int64(index)
+		f.grant(lease, c.Ttl)
+	} else if lease != 0 {
+		if _, ok := f.leases[lease]; !ok {
+			return ErrLeaseNotFound
+		}
+	}
+	f.put(index, c.Key, c.Value, lease)
+	return &applyResponse{succeeded: true, revision: index}
+}
+
+func (f *fsm) applyDelete(index uint64, k string, prevRevision uint64) interface{} {
+	if !f.revisionMatches(k, prevRevision) {
+		return &applyResponse{succeeded: false}
+	}
+	f.remove(index, k)
+	return &applyResponse{succeeded: true, revision: index}
+}
+
+func (f *fsm) applyCompareAndSwap(index uint64, k, expected, v string) interface{} {
+	if cur, ok := f.tx.get(k); !ok || cur.Value != expected {
+		return &applyResponse{succeeded: false}
+	}
+	f.put(index, k, v, 0)
+	return &applyResponse{succeeded: true, revision: index}
+}
+
+func (f *fsm) applySetIfAbsent(index uint64, k, v string) interface{} {
+	if _, ok := f.tx.get(k); ok {
+		return &applyResponse{succeeded: false}
+	}
+	f.put(index, k, v, 0)
+	return &applyResponse{succeeded: true, revision: index}
+}
+
+func (f *fsm) applyDeleteIfValue(index uint64, k, expected string) interface{} {
+	if cur, ok := f.tx.get(k); !ok || cur.Value != expected {
+		return &applyResponse{succeeded: false}
+	}
+	f.remove(index, k)
+	return &applyResponse{succeeded: true, revision: index}
+}
+
+func (f *fsm) applyBatch(index uint64, ops []*rpcservicepb.Command) interface{} {
+	// Validate the whole batch first so that it is applied all or nothing.
+	for _, op := range ops {
+		if op.Op != rpcservicepb.CommandOp_CMD_SET && op.Op != rpcservicepb.CommandOp_CMD_DELETE {
+			return fmt.Errorf("unsupported batch op: %s", op.Op)
+		}
+	}
 
-	delete(f.m, k)
+	for _, op := range ops {
+		if op.Op == rpcservicepb.CommandOp_CMD_SET {
+			f.put(index, op.Key, op.Value, 0)
+		} else {
+			f.remove(index, op.Key)
+		}
+	}
 	return nil
 }
 
@@ -45,47 +241,215 @@ func (f *fsm) Snapshot() (raft.FSMSnapshot, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
-	m := make(map[string]string)
-	for k, v := range f.m {
-		m[k] = v
+	codec, err := snapshotCodec(f.SnapshotCodec)
+	if err != nil {
+		return nil, err
+	}
+	checksum, err := snapshotChecksum(f.SnapshotChecksum)
+	if err != nil {
+		return nil, err
 	}
 
-	return &fsmSnapshot{store: m}, nil
+	// The keys are only copied by Persist, without holding the mutex.
+	snap, err := f.engine.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &fsmSnapshot{snap: snap, codec: codec, checksum: checksum}, nil
 }
 
 func (f *fsm) Restore(rc io.ReadCloser) error {
-	m := make(map[string]string)
-	if err := json.NewDecoder(rc).Decode(&m); err != nil {
+	br := bufio.NewReader(rc)
+	format, err := br.Peek(1)
+	if err != nil {
 		return err
 	}
 
-	f.m = m
+	switch format[0] {
+	case snapshotFormatV4, snapshotFormatV3:
+		br.ReadByte()
+		sr, err := newSnapshotReader(br, format[0])
+		if err != nil {
+			return err
+		}
+		return f.restore(func(tx engineTxn) error {
+			return sr.each(tx.put, tx.putLease, tx.putMeta)
+		})
+	case snapshotFormatV2:
+		br.ReadByte()
+		data := &snapshotData{}
+		if err := json.NewDecoder(br).Decode(data); err != nil {
+			return err
+		}
+		return f.restore(data.fill)
+	case legacyJSONPrefix:
+		data, err := decodeLegacySnapshot(br)
+		if err != nil {
+			return err
+		}
+		return f.restore(data.fill)
+	default:
+		return fmt.Errorf("unknown snapshot format: 0x%02x", format[0])
+	}
+}
+
+// restore replaces the engine content with what fill writes. Nothing is
+// kept if fill fails.
+func (f *fsm) restore(fill func(tx engineTxn) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	tx, err := f.engine.begin()
+	if err != nil {
+		return err
+	}
+	tx.clear()
+	if err := fill(tx); err != nil {
+		tx.rollback()
+		return err
+	}
+	// Restore does not know the index of the snapshot. The next entry
+	// applied records it, until then the engine claims no applied index.
+	if err := tx.commit(0); err != nil {
+		return err
+	}
+	f.recoveredIndex = 0
+
+	if err := f.loadLeases(); err != nil {
+		return err
+	}
+	f.watches.reset()
+	return nil
+}
+
+// loadLeases rebuilds the leases and the keys attached to them from the
+// engine. The caller must hold the mutex.
+func (f *fsm) loadLeases() error {
+	ttls, err := f.engine.leases()
+	if err != nil {
+		return err
+	}
+	leases := make(map[int64]*leaseEntry, len(ttls))
+	for id, ttl := range ttls {
+		leases[id] = &leaseEntry{ttl: ttl, keys: make(map[string]struct{})}
+	}
+	err = f.engine.ascend("", "", func(k string, e kvEntry) bool {
+		if l, ok := leases[e.Lease]; ok {
+			l.keys[k] = struct{}{}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	f.leases = leases
+	return nil
+}
+
+// decodeLegacySnapshot reads snapshots written before the format byte was
+// introduced: a JSON object mapping keys either straight to their values or,
+// once revisions were tracked, to a kvEntry.
+func decodeLegacySnapshot(r io.Reader) (*snapshotData, error) {
+	raw := make(map[string]json.RawMessage)
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	data := &snapshotData{Keys: make(map[string]kvEntry, len(raw))}
+	for k, b := range raw {
+		var e kvEntry
+		if len(b) > 0 && b[0] == '"' {
+			if err := json.Unmarshal(b, &e.Value); err != nil {
+				return nil, err
+			}
+			e.Version = 1
+		} else if err := json.Unmarshal(b, &e); err != nil {
+			return nil, err
+		}
+		data.Keys[k] = e
+	}
+	return data, nil
+}
+
+// snapshotFormatV2 prefixes snapshots holding a JSON encoded snapshotData.
+const snapshotFormatV2 byte = 0x02
+
+// snapshotData is the fsm state captured by a format 2 snapshot.
+type snapshotData struct {
+	Keys   map[string]kvEntry `json:"keys"`
+	Leases map[int64]int64    `json:"leases"` // lease id to ttl in seconds
+}
+
+func (d *snapshotData) fill(tx engineTxn) error {
+	for k, e := range d.Keys {
+		tx.put(k, e)
+	}
+	for id, ttl := range d.Leases {
+		tx.putLease(id, ttl)
+	}
 	return nil
 }
 
 type fsmSnapshot struct {
-	store map[string]string
+	snap     engineSnapshot
+	codec    byte
+	checksum byte
 }
 
+// Persist streams the snapshot to sink one record at a time, so the keys
+// are never all copied at once.
 func (f *fsmSnapshot) Persist(sink raft.SnapshotSink) error {
 	err := func() error {
-		b, err := json.Marshal(f.store)
+		sw, err := newSnapshotWriter(sink, f.codec, f.checksum)
+		if err != nil {
+			return err
+		}
+
+		leases, err := f.snap.leases()
+		if err != nil {
+			return err
+		}
+		for id, ttl := range leases {
+			if err := sw.writeLease(id, ttl); err != nil {
+				return err
+			}
+		}
+
+		meta, err := f.snap.metadata()
 		if err != nil {
 			return err
 		}
+		for k, v := range meta {
+			if err := sw.writeMeta(k, v); err != nil {
+				return err
+			}
+		}
 
-		if _, err := sink.Write(b); err != nil {
+		var werr error
+		err = f.snap.ascend("", "", func(k string, e kvEntry) bool {
+			werr = sw.writeKey(k, e)
+			return werr == nil
+		})
+		if err != nil {
 			return err
 		}
+		if werr != nil {
+			return werr
+		}
 
+		if err := sw.close(); err != nil {
+			return err
+		}
 		return sink.Close()
 	}()
 
 	if err != nil {
-		sink.Close()
+		sink.Cancel()
 	}
 
 	return err
 }
 
-func (f *fsmSnapshot) Release() {}
+func (f *fsmSnapshot) Release() {
+	f.snap.release()
+}
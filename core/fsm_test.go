@@ -0,0 +1,419 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	rpcservicepb "raft-grpc-demo/proto"
+)
+
+// applyTo feeds c to f as if it had been committed at the given index.
+func applyTo(t *testing.T, f *fsm, index uint64, c *rpcservicepb.Command) interface{} {
+	b, err := encodeCommand(c)
+	assert.Nil(t, err)
+	return f.Apply(&raft.Log{Index: index, Data: b})
+}
+
+// getFrom reads k from the fsm's local state.
+func getFrom(t *testing.T, f *fsm, k string) *KeyValue {
+	kv, err := (*Store)(f).GetKV(k, Stale)
+	assert.Nil(t, err)
+	return kv
+}
+
+func valueOf(t *testing.T, f *fsm, k string) string {
+	if kv := getFrom(t, f, k); kv != nil {
+		return kv.Value
+	}
+	return ""
+}
+
+func TestFsmApplyBatch(t *testing.T) {
+	t.Run("applies all ops", func(t *testing.T) {
+		f := (*fsm)(NewStore())
+		applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "gone", Value: "x"})
+		rsp := applyTo(t, f, 2, &rpcservicepb.Command{
+			Op: rpcservicepb.CommandOp_CMD_BATCH,
+			Ops: []*rpcservicepb.Command{
+				{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1"},
+				{Op: rpcservicepb.CommandOp_CMD_SET, Key: "b", Value: "2"},
+				{Op: rpcservicepb.CommandOp_CMD_DELETE, Key: "gone"},
+			},
+		})
+		assert.Nil(t, rsp)
+		assert.Equal(t, "1", valueOf(t, f, "a"))
+		assert.Equal(t, "2", valueOf(t, f, "b"))
+		assert.Nil(t, getFrom(t, f, "gone"))
+	})
+
+	t.Run("rejects invalid op atomically", func(t *testing.T) {
+		f := (*fsm)(NewStore())
+		rsp := applyTo(t, f, 1, &rpcservicepb.Command{
+			Op: rpcservicepb.CommandOp_CMD_BATCH,
+			Ops: []*rpcservicepb.Command{
+				{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1"},
+				{Op: rpcservicepb.CommandOp_CMD_BATCH},
+			},
+		})
+		assert.NotNil(t, rsp)
+		assert.Nil(t, getFrom(t, f, "a"))
+	})
+}
+
+func TestFsmConditionalWrites(t *testing.T) {
+	f := (*fsm)(NewStore())
+	succeeded := func(rsp interface{}) bool {
+		return rsp.(*applyResponse).succeeded
+	}
+
+	rsp := applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET_IF_ABSENT, Key: "k", Value: "1"})
+	assert.True(t, succeeded(rsp))
+	rsp = applyTo(t, f, 2, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET_IF_ABSENT, Key: "k", Value: "2"})
+	assert.False(t, succeeded(rsp))
+	assert.Equal(t, "1", valueOf(t, f, "k"))
+
+	rsp = applyTo(t, f, 3, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_COMPARE_AND_SWAP, Key: "k", Expected: "0", Value: "2"})
+	assert.False(t, succeeded(rsp))
+	rsp = applyTo(t, f, 4, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_COMPARE_AND_SWAP, Key: "k", Expected: "1", Value: "2"})
+	assert.True(t, succeeded(rsp))
+	assert.Equal(t, "2", valueOf(t, f, "k"))
+
+	rsp = applyTo(t, f, 5, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_DELETE_IF_VALUE, Key: "k", Expected: "1"})
+	assert.False(t, succeeded(rsp))
+	rsp = applyTo(t, f, 6, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_DELETE_IF_VALUE, Key: "k", Expected: "2"})
+	assert.True(t, succeeded(rsp))
+	assert.Nil(t, getFrom(t, f, "k"))
+}
+
+func TestFsmRevisions(t *testing.T) {
+	f := (*fsm)(NewStore())
+
+	applyTo(t, f, 3, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "k", Value: "1"})
+	applyTo(t, f, 7, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "k", Value: "2"})
+	assert.Equal(t, &KeyValue{Key: "k", Value: "2", CreateRevision: 3, ModRevision: 7, Version: 2}, getFrom(t, f, "k"))
+
+	rsp := applyTo(t, f, 8, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "k", Value: "3", PrevRevision: 3})
+	assert.False(t, rsp.(*applyResponse).succeeded)
+	rsp = applyTo(t, f, 9, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "k", Value: "3", PrevRevision: 7})
+	assert.Equal(t, &applyResponse{succeeded: true, revision: 9}, rsp)
+
+	rsp = applyTo(t, f, 10, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_DELETE, Key: "k", PrevRevision: 7})
+	assert.False(t, rsp.(*applyResponse).succeeded)
+	rsp = applyTo(t, f, 11, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_DELETE, Key: "k", PrevRevision: 9})
+	assert.True(t, rsp.(*applyResponse).succeeded)
+	assert.Nil(t, getFrom(t, f, "k"))
+}
+
+// persist returns the snapshot of f as written to a sink.
+func persist(t *testing.T, f *fsm) []byte {
+	snap, err := f.Snapshot()
+	assert.Nil(t, err)
+	defer snap.Release()
+
+	store := raft.NewInmemSnapshotStore()
+	sink, err := store.Create(raft.SnapshotVersionMax, 1, 1, raft.Configuration{}, 1, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, snap.Persist(sink))
+	_, rc, err := store.Open(sink.ID())
+	assert.Nil(t, err)
+	b, err := ioutil.ReadAll(rc)
+	assert.Nil(t, err)
+	return b
+}
+
+func TestFsmSnapshotRestore(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		f := (*fsm)(NewStore())
+		applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1"})
+		applyTo(t, f, 2, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "2"})
+		applyTo(t, f, 3, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "b", Value: "3"})
+
+		snap, err := f.Snapshot()
+		assert.Nil(t, err)
+		store := raft.NewInmemSnapshotStore()
+		sink, err := store.Create(raft.SnapshotVersionMax, 3, 1, raft.Configuration{}, 1, nil)
+		assert.Nil(t, err)
+		assert.Nil(t, snap.Persist(sink))
+
+		_, rc, err := store.Open(sink.ID())
+		assert.Nil(t, err)
+		restored := (*fsm)(NewStore())
+		assert.Nil(t, restored.Restore(rc))
+		assert.Equal(t, getFrom(t, f, "a"), getFrom(t, restored, "a"))
+		assert.Equal(t, getFrom(t, f, "b"), getFrom(t, restored, "b"))
+	})
+
+	t.Run("compressed", func(t *testing.T) {
+		f := (*fsm)(NewStore())
+		f.SnapshotCodec = "gzip"
+		applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1", Ttl: 5})
+
+		b := persist(t, f)
+		assert.Equal(t, []byte{snapshotFormatV4, codecGzip, checksumCRC32C}, b[:3])
+
+		restored := (*fsm)(NewStore())
+		assert.Nil(t, restored.Restore(ioutil.NopCloser(bytes.NewReader(b))))
+		assert.Equal(t, getFrom(t, f, "a"), getFrom(t, restored, "a"))
+		assert.Equal(t, int64(5), restored.leases[1].ttl)
+		assert.Contains(t, restored.leases[1].keys, "a")
+	})
+
+	t.Run("codecs and checksums", func(t *testing.T) {
+		for _, codec := range []string{"none", "flate", "gzip"} {
+			for _, checksum := range []string{"crc32c", "sha256"} {
+				f := (*fsm)(NewStore())
+				f.SnapshotCodec, f.SnapshotChecksum = codec, checksum
+				applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1"})
+
+				restored := (*fsm)(NewStore())
+				assert.Nil(t, restored.Restore(ioutil.NopCloser(bytes.NewReader(persist(t, f)))), codec+"/"+checksum)
+				assert.Equal(t, getFrom(t, f, "a"), getFrom(t, restored, "a"))
+			}
+		}
+
+		f := (*fsm)(NewStore())
+		f.SnapshotCodec = "zstd"
+		_, err := f.Snapshot()
+		assert.NotNil(t, err)
+	})
+
+	t.Run("format 3", func(t *testing.T) {
+		var records bytes.Buffer
+		sw := &snapshotWriter{w: &records}
+		assert.Nil(t, sw.writeKey("a", kvEntry{Value: "1", CreateRevision: 1, ModRevision: 1, Version: 1}))
+		assert.Nil(t, sw.writeRecord([]byte{recordEnd}))
+		sum := make([]byte, 4)
+		binary.BigEndian.PutUint32(sum, crc32.Checksum(records.Bytes(), crcTable))
+
+		b := append([]byte{snapshotFormatV3, codecNone}, records.Bytes()...)
+		f := (*fsm)(NewStore())
+		assert.Nil(t, f.Restore(ioutil.NopCloser(bytes.NewReader(append(b, sum...)))))
+		assert.Equal(t, "1", valueOf(t, f, "a"))
+	})
+
+	t.Run("corrupt snapshot leaves state untouched", func(t *testing.T) {
+		f := (*fsm)(NewStore())
+		applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1"})
+		b := persist(t, f)
+
+		restored := (*fsm)(NewStore())
+		applyTo(t, restored, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "kept", Value: "x"})
+
+		// Flip the value of "a" so that only the checksum can tell.
+		corrupt := append([]byte(nil), b...)
+		i := bytes.Index(corrupt, []byte("\x01a\x011"))
+		assert.True(t, i > 0)
+		corrupt[i+3] = '2'
+		err := restored.Restore(ioutil.NopCloser(bytes.NewReader(corrupt)))
+		assert.True(t, errors.Is(err, ErrSnapshotChecksum))
+		assert.Equal(t, "x", valueOf(t, restored, "kept"))
+
+		err = restored.Restore(ioutil.NopCloser(bytes.NewReader(b[:len(b)-2])))
+		assert.NotNil(t, err)
+		assert.Equal(t, "x", valueOf(t, restored, "kept"))
+		assert.Nil(t, getFrom(t, restored, "a"))
+	})
+
+	t.Run("legacy format", func(t *testing.T) {
+		f := (*fsm)(NewStore())
+		rc := ioutil.NopCloser(bytes.NewBufferString(`{"a":"1"}`))
+		assert.Nil(t, f.Restore(rc))
+		assert.Equal(t, &KeyValue{Key: "a", Value: "1", Version: 1}, getFrom(t, f, "a"))
+	})
+}
+
+func TestFsmMeta(t *testing.T) {
+	setMeta := func(f *fsm, index uint64, k, v string) {
+		applyTo(t, f, index, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_META_SET, Key: k, Value: v})
+	}
+	metaOf := func(f *fsm, k string) string {
+		v, err := (*Store)(f).GetMeta(k)
+		assert.Nil(t, err)
+		return v
+	}
+
+	t.Run("apart from user keys", func(t *testing.T) {
+		f := (*fsm)(NewStore())
+		setMeta(f, 1, "n1", "127.0.0.1:51000")
+		applyTo(t, f, 2, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "n1", Value: "user"})
+		assert.Equal(t, "127.0.0.1:51000", metaOf(f, "n1"))
+		assert.Equal(t, "user", valueOf(t, f, "n1"))
+
+		applyTo(t, f, 3, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_DELETE, Key: "n1"})
+		assert.Equal(t, "127.0.0.1:51000", metaOf(f, "n1"))
+		rr, err := (*Store)(f).Range(RangeOptions{}, Stale)
+		assert.Nil(t, err)
+		assert.Empty(t, rr.KVs)
+
+		applyTo(t, f, 4, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_META_DELETE, Key: "n1"})
+		assert.Equal(t, "", metaOf(f, "n1"))
+	})
+
+	t.Run("snapshot section", func(t *testing.T) {
+		f := (*fsm)(NewStore())
+		setMeta(f, 1, "n1", "127.0.0.1:51000")
+		applyTo(t, f, 2, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1"})
+
+		restored := (*fsm)(NewStore())
+		setMeta(restored, 1, "n9", "gone")
+		assert.Nil(t, restored.Restore(ioutil.NopCloser(bytes.NewReader(persist(t, f)))))
+		assert.Equal(t, "127.0.0.1:51000", metaOf(restored, "n1"))
+		assert.Equal(t, "", metaOf(restored, "n9"))
+		assert.Equal(t, "", valueOf(t, restored, "n1"))
+		assert.Equal(t, "1", valueOf(t, restored, "a"))
+	})
+
+	t.Run("bolt engine", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "engine")
+		assert.Nil(t, err)
+		defer os.RemoveAll(dir)
+
+		f := newBoltFsm(t, dir)
+		setMeta(f, 1, "n1", "127.0.0.1:51000")
+		snap := persist(t, f)
+		assert.Nil(t, f.engine.close())
+
+		f = newBoltFsm(t, dir)
+		defer f.engine.close()
+		assert.Equal(t, "127.0.0.1:51000", metaOf(f, "n1"))
+		setMeta(f, 2, "n2", "127.0.0.1:51001")
+		assert.Nil(t, f.Restore(ioutil.NopCloser(bytes.NewReader(snap))))
+		assert.Equal(t, "127.0.0.1:51000", metaOf(f, "n1"))
+		assert.Equal(t, "", metaOf(f, "n2"))
+	})
+}
+
+func TestFsmLeases(t *testing.T) {
+	f := (*fsm)(NewStore())
+
+	rsp := applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_LEASE_GRANT, Ttl: 10})
+	assert.Equal(t, &applyResponse{succeeded: true, revision: 1}, rsp)
+	applyTo(t, f, 2, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "1", Lease: 1})
+	applyTo(t, f, 3, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "b", Value: "2", Lease: 1})
+	applyTo(t, f, 4, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "c", Value: "3", Ttl: 5})
+	assert.Equal(t, int64(1), getFrom(t, f, "a").Lease)
+	assert.Equal(t, int64(4), getFrom(t, f, "c").Lease)
+
+	rsp = applyTo(t, f, 5, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "d", Value: "4", Lease: 42})
+	assert.Equal(t, ErrLeaseNotFound, rsp)
+
+	// Overwriting without a lease detaches the key.
+	applyTo(t, f, 6, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "b", Value: "5"})
+
+	snap, err := f.Snapshot()
+	assert.Nil(t, err)
+	leases, err := snap.(*fsmSnapshot).snap.leases()
+	assert.Nil(t, err)
+	assert.Equal(t, map[int64]int64{1: 10, 4: 5}, leases)
+	snap.Release()
+
+	rsp = applyTo(t, f, 7, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_LEASE_REVOKE, Lease: 1})
+	assert.True(t, rsp.(*applyResponse).succeeded)
+	assert.Nil(t, getFrom(t, f, "a"))
+	assert.Equal(t, "5", valueOf(t, f, "b"))
+	assert.Equal(t, "3", valueOf(t, f, "c"))
+
+	rsp = applyTo(t, f, 8, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_LEASE_REVOKE, Lease: 1})
+	assert.False(t, rsp.(*applyResponse).succeeded)
+
+	// A write cannot be attached to a lease and to a ttl of its own.
+	_, err = (*Store)(f).SetWithOptions("d", "4", WriteOptions{TTL: 5 * time.Second, Lease: 4})
+	assert.Equal(t, ErrTTLWithLease, err)
+}
+
+func TestExpiredLeases(t *testing.T) {
+	s := NewStore()
+	applyTo(t, (*fsm)(s), 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_LEASE_GRANT, Ttl: 10})
+
+	now := time.Now()
+	assert.Empty(t, s.expiredLeases(now))
+	assert.Empty(t, s.expiredLeases(now.Add(5*time.Second)))
+	assert.Equal(t, []int64{1}, s.expiredLeases(now.Add(11*time.Second)))
+
+	applyTo(t, (*fsm)(s), 2, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_LEASE_REVOKE, Lease: 1})
+	assert.Empty(t, s.expiredLeases(now.Add(11*time.Second)))
+	assert.Empty(t, s.leaseDeadlines)
+}
+
+func TestRange(t *testing.T) {
+	s := NewStore()
+	for i, k := range []string{"a", "b/1", "b/2", "b/3", "c"} {
+		applyTo(t, (*fsm)(s), uint64(i+1), &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: k, Value: k})
+	}
+	keys := func(rr *RangeResult) []string {
+		var ks []string
+		for _, kv := range rr.KVs {
+			ks = append(ks, kv.Key)
+		}
+		return ks
+	}
+
+	rr, err := s.Range(RangeOptions{Prefix: "b/", Limit: 2}, Stale)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b/1", "b/2"}, keys(rr))
+	assert.True(t, rr.More)
+	assert.Equal(t, encodeContinue("b/3"), rr.Continue)
+
+	rr, err = s.Range(RangeOptions{Prefix: "b/", Limit: 2, Continue: rr.Continue}, Stale)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b/3"}, keys(rr))
+	assert.False(t, rr.More)
+
+	rr, err = s.Range(RangeOptions{Start: "a", End: "c", KeysOnly: true}, Stale)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b/1", "b/2", "b/3"}, keys(rr))
+	assert.Equal(t, "", rr.KVs[0].Value)
+
+	rr, err = s.Range(RangeOptions{Start: "b", CountOnly: true}, Stale)
+	assert.Nil(t, err)
+	assert.Empty(t, rr.KVs)
+	assert.Equal(t, 4, rr.Count)
+
+	_, err = s.Range(RangeOptions{Prefix: "b/", Continue: encodeContinue("c")}, Stale)
+	assert.Equal(t, ErrInvalidContinue, err)
+}
+
+func TestFsmTxn(t *testing.T) {
+	f := (*fsm)(NewStore())
+	applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "counter", Value: "1"})
+
+	txn := func(index uint64, cmp *rpcservicepb.Command_Compare) *applyResponse {
+		return applyTo(t, f, index, &rpcservicepb.Command{
+			Op:       rpcservicepb.CommandOp_CMD_TXN,
+			Compares: []*rpcservicepb.Command_Compare{cmp},
+			Success: []*rpcservicepb.Command{
+				{Op: rpcservicepb.CommandOp_CMD_SET, Key: "counter", Value: "2"},
+				{Op: rpcservicepb.CommandOp_CMD_GET, Key: "counter"},
+			},
+			Failure: []*rpcservicepb.Command{
+				{Op: rpcservicepb.CommandOp_CMD_GET, Key: "counter"},
+				{Op: rpcservicepb.CommandOp_CMD_DELETE, Key: "missing"},
+			},
+		}).(*applyResponse)
+	}
+
+	rsp := txn(2, &rpcservicepb.Command_Compare{Key: "counter", Target: rpcservicepb.Command_Compare_MOD_REVISION, Number: 1})
+	assert.True(t, rsp.succeeded)
+	assert.Equal(t, uint64(2), rsp.revision)
+	assert.Equal(t, "2", rsp.results[1].KV.Value)
+
+	rsp = txn(3, &rpcservicepb.Command_Compare{Key: "counter", Target: rpcservicepb.Command_Compare_VALUE, Value: "1"})
+	assert.False(t, rsp.succeeded)
+	assert.Equal(t, "2", rsp.results[0].KV.Value)
+	assert.False(t, rsp.results[1].Deleted)
+
+	rsp = txn(4, &rpcservicepb.Command_Compare{Key: "counter", Target: rpcservicepb.Command_Compare_VERSION, Result: rpcservicepb.Command_Compare_GREATER, Number: 1})
+	assert.True(t, rsp.succeeded)
+
+	rsp = txn(5, &rpcservicepb.Command_Compare{Key: "missing", Target: rpcservicepb.Command_Compare_EXISTS, Result: rpcservicepb.Command_Compare_NOT_EQUAL})
+	assert.True(t, rsp.succeeded)
+	assert.Equal(t, uint64(4), getFrom(t, f, "counter").Version)
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"github.com/google/btree"
+)
+
+const indexDegree = 32
+
+// kvItem is a key and its entry as stored in the index. Items are never
+// modified once inserted since clones of the tree share them.
+type kvItem struct {
+	key   string
+	entry kvEntry
+}
+
+func (i *kvItem) Less(than btree.Item) bool {
+	return i.key < than.(*kvItem).key
+}
+
+// kvIndex keeps the keys of the fsm ordered so that they can be scanned by
+// range or prefix. It is guarded by Store.mutex.
+type kvIndex struct {
+	tree *btree.BTree
+}
+
+func newKVIndex() *kvIndex {
+	return &kvIndex{tree: btree.New(indexDegree)}
+}
+
+func (x *kvIndex) get(k string) (kvEntry, bool) {
+	item := x.tree.Get(&kvItem{key: k})
+	if item == nil {
+		return kvEntry{}, false
+	}
+	return item.(*kvItem).entry, true
+}
+
+func (x *kvIndex) set(k string, e kvEntry) {
+	x.tree.ReplaceOrInsert(&kvItem{key: k, entry: e})
+}
+
+func (x *kvIndex) delete(k string) {
+	x.tree.Delete(&kvItem{key: k})
+}
+
+func (x *kvIndex) len() int {
+	return x.tree.Len()
+}
+
+// ascend calls fn for the keys in [start, end) in order until it returns
+// false. An empty end means no upper bound.
+func (x *kvIndex) ascend(start, end string, fn func(k string, e kvEntry) bool) {
+	iter := func(i btree.Item) bool {
+		item := i.(*kvItem)
+		return fn(item.key, item.entry)
+	}
+	if end == "" {
+		x.tree.AscendGreaterOrEqual(&kvItem{key: start}, iter)
+		return
+	}
+	x.tree.AscendRange(&kvItem{key: start}, &kvItem{key: end}, iter)
+}
+
+// clone returns a copy-on-write copy of the index. It is cheap, and later
+// writes to either copy are not visible in the other one.
+func (x *kvIndex) clone() *kvIndex {
+	return &kvIndex{tree: x.tree.Clone()}
+}
+
+// prefixEnd returns the smallest key greater than every key starting with
+// prefix, or "" if there is none.
+func prefixEnd(prefix string) string {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,171 @@
+package core
+
+import (
+	"github.com/hashicorp/raft"
+	rpcservicepb "raft-grpc-demo/proto"
+	"time"
+)
+
+// leaseEntry is a lease tracked by the fsm. It only records the ttl; when a
+// lease expires is decided by the leader, never by the fsm itself, so that
+// replicas with skewed clocks still delete the same keys at the same index.
+type leaseEntry struct {
+	ttl  int64 // seconds
+	keys map[string]struct{}
+}
+
+// grant creates the lease id. The caller must hold the mutex and run inside
+// Apply.
+func (f *fsm) grant(id, ttl int64) {
+	f.leases[id] = &leaseEntry{ttl: ttl, keys: make(map[string]struct{})}
+	f.tx.putLease(id, ttl)
+}
+
+// detach removes k from the keys attached to lease. The caller must hold the
+// mutex.
+func (f *fsm) detach(k string, lease int64) {
+	if l, ok := f.leases[lease]; ok {
+		delete(l.keys, k)
+	}
+}
+
+func (f *fsm) applyLeaseGrant(index uint64, ttl int64) interface{} {
+	f.grant(int64(index), ttl)
+	return &applyResponse{succeeded: true, revision: index}
+}
+
+func (f *fsm) applyLeaseRevoke(index uint64, id int64) interface{} {
+	l, ok := f.leases[id]
+	if !ok {
+		return &applyResponse{succeeded: false}
+	}
+	for k := range l.keys {
+		f.remove(index, k)
+	}
+	delete(f.leases, id)
+	f.tx.deleteLease(id)
+	return &applyResponse{succeeded: true, revision: index}
+}
+
+//GrantLease creates a lease with the given ttl and returns its id. Lease ids
+//are the raft index of the grant.
+func (s *Store) GrantLease(ttl time.Duration) (int64, error) {
+	seconds := int64(ttl / time.Second)
+	if seconds < 1 {
+		return 0, ErrInvalidTTL
+	}
+
+	r, err := s.applyWrite(&rpcservicepb.Command{
+		Op:  rpcservicepb.CommandOp_CMD_LEASE_GRANT,
+		Ttl: seconds,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	id := int64(r.Revision)
+	s.leaseMu.Lock()
+	s.leaseDeadlines[id] = time.Now().Add(ttl)
+	s.leaseMu.Unlock()
+	return id, nil
+}
+
+//KeepAliveLease renews the lease on the leader and returns its ttl.
+//Renewals are not replicated: a new leader grants every lease a full ttl.
+func (s *Store) KeepAliveLease(id int64) (time.Duration, error) {
+	if s.raft.State() != raft.Leader {
+		return 0, ErrNotLeader
+	}
+
+	s.mutex.Lock()
+	l, ok := s.leases[id]
+	var ttl time.Duration
+	if ok {
+		ttl = time.Duration(l.ttl) * time.Second
+	}
+	s.mutex.Unlock()
+	if !ok {
+		return 0, ErrLeaseNotFound
+	}
+
+	s.leaseMu.Lock()
+	s.leaseDeadlines[id] = time.Now().Add(ttl)
+	s.leaseMu.Unlock()
+	return ttl, nil
+}
+
+//RevokeLease deletes the lease and every key attached to it
+func (s *Store) RevokeLease(id int64) error {
+	r, err := s.applyWrite(&rpcservicepb.Command{
+		Op:    rpcservicepb.CommandOp_CMD_LEASE_REVOKE,
+		Lease: id,
+	})
+	if err != nil {
+		return err
+	}
+	if !r.Succeeded {
+		return ErrLeaseNotFound
+	}
+	return nil
+}
+
+// runLeaseExpiry periodically revokes the leases whose deadline passed.
+// Only the leader acts, and it does so by committing CMD_LEASE_REVOKE, so
+// expiry is applied at the same log index on every replica.
+func (s *Store) runLeaseExpiry() {
+	tck := time.NewTicker(leaseCheckInterval)
+	defer tck.Stop()
+
+	for {
+		select {
+		case <-tck.C:
+		case <-s.shutdownCh:
+			return
+		}
+		if s.raft.State() != raft.Leader {
+			// Deadlines are rebuilt from scratch if we become leader again.
+			s.leaseMu.Lock()
+			s.leaseDeadlines = make(map[int64]time.Time)
+			s.leaseMu.Unlock()
+			continue
+		}
+
+		for _, id := range s.expiredLeases(time.Now()) {
+			if err := s.RevokeLease(id); err != nil && err != ErrLeaseNotFound {
+				s.logger.Printf("failed to revoke expired lease %d: %v", id, err)
+			}
+		}
+	}
+}
+
+// expiredLeases returns the leases whose deadline is before now. Leases
+// without a deadline yet, e.g. after a leader change, get a full ttl.
+func (s *Store) expiredLeases(now time.Time) []int64 {
+	s.mutex.Lock()
+	ttls := make(map[int64]int64, len(s.leases))
+	for id, l := range s.leases {
+		ttls[id] = l.ttl
+	}
+	s.mutex.Unlock()
+
+	s.leaseMu.Lock()
+	defer s.leaseMu.Unlock()
+
+	var expired []int64
+	for id, ttl := range ttls {
+		deadline, ok := s.leaseDeadlines[id]
+		if !ok {
+			s.leaseDeadlines[id] = now.Add(time.Duration(ttl) * time.Second)
+			continue
+		}
+		if now.After(deadline) {
+			expired = append(expired, id)
+		}
+	}
+	for id := range s.leaseDeadlines {
+		if _, ok := ttls[id]; !ok {
+			delete(s.leaseDeadlines, id)
+		}
+	}
+	return expired
+}
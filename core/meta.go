@@ -0,0 +1,55 @@
+package core
+
+import (
+	"github.com/hashicorp/raft"
+	rpcservicepb "raft-grpc-demo/proto"
+)
+
+// The cluster metadata maps node ids to the grpc address of the node. It is
+// kept in the fsm apart from the user keys: the user Get, Set and Delete
+// cannot reach it, it has no revisions and its changes are not watched.
+
+//SetMeta sets key of the cluster metadata to value
+func (s *Store) SetMeta(key, value string) error {
+	if s.raft.State() != raft.Leader {
+		return ErrNotLeader
+	}
+	_, err := s.applyCommand(&rpcservicepb.Command{
+		Op:    rpcservicepb.CommandOp_CMD_META_SET,
+		Key:   key,
+		Value: value,
+	})
+	return err
+}
+
+//GetMeta returns key of the cluster metadata from the local state, empty if
+//it is not set
+func (s *Store) GetMeta(key string) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	v, _, err := s.engine.getMeta(key)
+	return v, err
+}
+
+//DeleteMeta deletes key from the cluster metadata
+func (s *Store) DeleteMeta(key string) error {
+	if s.raft.State() != raft.Leader {
+		return ErrNotLeader
+	}
+	_, err := s.applyCommand(&rpcservicepb.Command{
+		Op:  rpcservicepb.CommandOp_CMD_META_DELETE,
+		Key: key,
+	})
+	return err
+}
+
+func (f *fsm) applyMetaSet(k, v string) interface{} {
+	f.tx.putMeta(k, v)
+	return nil
+}
+
+func (f *fsm) applyMetaDelete(k string) interface{} {
+	f.tx.deleteMeta(k)
+	return nil
+}
@@ -0,0 +1,398 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+)
+
+// snapshotFormatV4 prefixes streamed snapshots. It is followed by a header
+// naming the codec compressing the rest of the stream and the checksum
+// algorithm, then by records:
+//
+//	header = codec checksum
+//	record = uvarint(len(body)) body
+//	body   = type payload
+//
+// A record of type recordEnd closes the stream. It is followed by the
+// checksum of the format byte, the header and every record before it.
+const snapshotFormatV4 byte = 0x04
+
+// snapshotFormatV3 is snapshotFormatV4 without the checksum byte in the
+// header. Its checksum is the CRC-32C of the records only.
+const snapshotFormatV3 byte = 0x03
+
+// Codecs compressing the records of a snapshot
+const (
+	codecNone  byte = 0x00
+	codecFlate byte = 0x01
+	codecGzip  byte = 0x02
+)
+
+// Checksum algorithms of a snapshot
+const (
+	checksumCRC32C byte = 0x01
+	checksumSHA256 byte = 0x02
+)
+
+const (
+	recordEnd   byte = 0x00
+	recordKey   byte = 0x01 // key, value, create and mod revisions, version, lease
+	recordLease byte = 0x02 // id, ttl in seconds
+	recordMeta  byte = 0x03 // key, value of the cluster metadata
+
+	// maxSnapshotRecord bounds the size of a record so that a corrupt length
+	// cannot make Restore allocate without limit.
+	maxSnapshotRecord = 256 << 20
+)
+
+// Names of the codecs and checksums accepted by Store.SnapshotCodec and
+// Store.SnapshotChecksum
+var (
+	snapshotCodecs = map[string]byte{
+		"":      codecNone,
+		"none":  codecNone,
+		"flate": codecFlate,
+		"gzip":  codecGzip,
+	}
+	snapshotChecksums = map[string]byte{
+		"":       checksumCRC32C,
+		"crc32c": checksumCRC32C,
+		"sha256": checksumSHA256,
+	}
+)
+
+var (
+	// ErrSnapshotChecksum is returned by Restore when the snapshot does not
+	// match its checksum. The fsm state is left untouched.
+	ErrSnapshotChecksum = errors.New("snapshot checksum mismatch")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// snapshotCodec returns the codec called name
+func snapshotCodec(name string) (byte, error) {
+	codec, ok := snapshotCodecs[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown snapshot codec %q", name)
+	}
+	return codec, nil
+}
+
+// snapshotChecksum returns the checksum algorithm called name
+func snapshotChecksum(name string) (byte, error) {
+	checksum, ok := snapshotChecksums[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown snapshot checksum %q", name)
+	}
+	return checksum, nil
+}
+
+func newChecksum(checksum byte) (hash.Hash, error) {
+	switch checksum {
+	case checksumCRC32C:
+		return crc32.New(crcTable), nil
+	case checksumSHA256:
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unknown snapshot checksum: 0x%02x", checksum)
+	}
+}
+
+func checksumName(checksum byte) string {
+	for name, c := range snapshotChecksums {
+		if name != "" && c == checksum {
+			return name
+		}
+	}
+	return fmt.Sprintf("0x%02x", checksum)
+}
+
+// snapshotWriter streams the fsm state to a snapshot sink record by record.
+type snapshotWriter struct {
+	bw  *bufio.Writer
+	cw  io.WriteCloser // compressing writer, nil if uncompressed
+	out io.Writer      // the checksum is written to out
+	w   io.Writer      // records go through w, which feeds sum
+	sum hash.Hash
+	buf []byte
+}
+
+func newSnapshotWriter(w io.Writer, codec, checksum byte) (*snapshotWriter, error) {
+	sum, err := newChecksum(checksum)
+	if err != nil {
+		return nil, err
+	}
+	sw := &snapshotWriter{bw: bufio.NewWriter(w), sum: sum}
+
+	header := []byte{snapshotFormatV4, codec, checksum}
+	if _, err := sw.bw.Write(header); err != nil {
+		return nil, err
+	}
+	sum.Write(header)
+
+	sw.out = sw.bw
+	switch codec {
+	case codecNone:
+	case codecFlate:
+		if sw.cw, err = flate.NewWriter(sw.bw, flate.DefaultCompression); err != nil {
+			return nil, err
+		}
+	case codecGzip:
+		sw.cw = gzip.NewWriter(sw.bw)
+	default:
+		return nil, fmt.Errorf("unknown snapshot codec: 0x%02x", codec)
+	}
+	if sw.cw != nil {
+		sw.out = sw.cw
+	}
+	sw.w = io.MultiWriter(sw.out, sum)
+	return sw, nil
+}
+
+func (sw *snapshotWriter) writeKey(k string, e kvEntry) error {
+	b := append(sw.buf[:0], recordKey)
+	b = appendString(b, k)
+	b = appendString(b, e.Value)
+	b = appendUvarint(b, e.CreateRevision)
+	b = appendUvarint(b, e.ModRevision)
+	b = appendUvarint(b, e.Version)
+	b = appendVarint(b, e.Lease)
+	return sw.writeRecord(b)
+}
+
+func (sw *snapshotWriter) writeLease(id, ttl int64) error {
+	b := append(sw.buf[:0], recordLease)
+	b = appendVarint(b, id)
+	b = appendVarint(b, ttl)
+	return sw.writeRecord(b)
+}
+
+func (sw *snapshotWriter) writeMeta(k, v string) error {
+	b := append(sw.buf[:0], recordMeta)
+	b = appendString(b, k)
+	b = appendString(b, v)
+	return sw.writeRecord(b)
+}
+
+func (sw *snapshotWriter) writeRecord(body []byte) error {
+	sw.buf = body
+	if _, err := sw.w.Write(appendUvarint(nil, uint64(len(body)))); err != nil {
+		return err
+	}
+	_, err := sw.w.Write(body)
+	return err
+}
+
+// close writes the end record and the checksum and flushes the stream. It
+// does not close the underlying writer.
+func (sw *snapshotWriter) close() error {
+	if err := sw.writeRecord(append(sw.buf[:0], recordEnd)); err != nil {
+		return err
+	}
+	if _, err := sw.out.Write(sw.sum.Sum(nil)); err != nil {
+		return err
+	}
+	if sw.cw != nil {
+		if err := sw.cw.Close(); err != nil {
+			return err
+		}
+	}
+	return sw.bw.Flush()
+}
+
+// snapshotReader reads the records written by a snapshotWriter.
+type snapshotReader struct {
+	r        *bufio.Reader
+	sum      hash.Hash
+	checksum byte
+	buf      []byte
+}
+
+// newSnapshotReader reads the header of a snapshot of the given format. br
+// must be positioned after the format byte.
+func newSnapshotReader(br *bufio.Reader, format byte) (*snapshotReader, error) {
+	header := []byte{format, 0, checksumCRC32C}
+	n := 3
+	if format == snapshotFormatV3 {
+		n = 2
+	}
+	if _, err := io.ReadFull(br, header[1:n]); err != nil {
+		return nil, fmt.Errorf("read snapshot header: %v", noEOF(err))
+	}
+
+	sum, err := newChecksum(header[2])
+	if err != nil {
+		return nil, err
+	}
+	if format == snapshotFormatV4 {
+		sum.Write(header)
+	}
+
+	r := br
+	switch codec := header[1]; codec {
+	case codecNone:
+	case codecFlate:
+		r = bufio.NewReader(flate.NewReader(br))
+	case codecGzip:
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("read snapshot: %v", err)
+		}
+		r = bufio.NewReader(zr)
+	default:
+		return nil, fmt.Errorf("unknown snapshot codec: 0x%02x", codec)
+	}
+	return &snapshotReader{r: r, sum: sum, checksum: header[2]}, nil
+}
+
+// each calls key, lease and meta for every record until the end record,
+// then verifies the checksum. It returns an error wrapping
+// ErrSnapshotChecksum if it does not match, the records read so far must
+// then be discarded.
+func (sr *snapshotReader) each(key func(k string, e kvEntry), lease func(id, ttl int64), meta func(k, v string)) error {
+	for {
+		body, err := sr.next()
+		if err != nil {
+			return err
+		}
+
+		d := recordDecoder{b: body[1:]}
+		switch body[0] {
+		case recordKey:
+			k := d.string()
+			e := kvEntry{Value: d.string()}
+			e.CreateRevision = d.uvarint()
+			e.ModRevision = d.uvarint()
+			e.Version = d.uvarint()
+			e.Lease = d.varint()
+			if d.err != nil {
+				return d.err
+			}
+			key(k, e)
+		case recordLease:
+			id, ttl := d.varint(), d.varint()
+			if d.err != nil {
+				return d.err
+			}
+			lease(id, ttl)
+		case recordMeta:
+			k, v := d.string(), d.string()
+			if d.err != nil {
+				return d.err
+			}
+			meta(k, v)
+		case recordEnd:
+			want := make([]byte, sr.sum.Size())
+			if _, err := io.ReadFull(sr.r, want); err != nil {
+				return fmt.Errorf("read snapshot checksum: %v", noEOF(err))
+			}
+			if got := sr.sum.Sum(nil); !bytes.Equal(got, want) {
+				return fmt.Errorf("%w: %s is %x, expected %x", ErrSnapshotChecksum, checksumName(sr.checksum), got, want)
+			}
+			return nil
+		default:
+			return fmt.Errorf("unknown snapshot record type: 0x%02x", body[0])
+		}
+	}
+}
+
+func (sr *snapshotReader) next() ([]byte, error) {
+	n, err := binary.ReadUvarint(sr.r)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot record: %v", noEOF(err))
+	}
+	if n == 0 || n > maxSnapshotRecord {
+		return nil, fmt.Errorf("invalid snapshot record length %d", n)
+	}
+	if uint64(cap(sr.buf)) < n {
+		sr.buf = make([]byte, n)
+	}
+	body := sr.buf[:n]
+	if _, err := io.ReadFull(sr.r, body); err != nil {
+		return nil, fmt.Errorf("read snapshot record: %v", noEOF(err))
+	}
+
+	sr.sum.Write(appendUvarint(nil, n))
+	sr.sum.Write(body)
+	return body, nil
+}
+
+// recordDecoder reads the fields of a record body, keeping the first error.
+type recordDecoder struct {
+	b   []byte
+	err error
+}
+
+var errShortRecord = errors.New("truncated snapshot record")
+
+func (d *recordDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.b)
+	if n <= 0 {
+		d.err = errShortRecord
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *recordDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.b)
+	if n <= 0 {
+		d.err = errShortRecord
+		return 0
+	}
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *recordDecoder) string() string {
+	n := d.uvarint()
+	if d.err != nil {
+		return ""
+	}
+	if uint64(len(d.b)) < n {
+		d.err = errShortRecord
+		return ""
+	}
+	s := string(d.b[:n])
+	d.b = d.b[n:]
+	return s
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func appendVarint(b []byte, v int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutVarint(buf[:], v)]...)
+}
+
+func appendString(b []byte, s string) []byte {
+	b = appendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// noEOF turns an EOF in the middle of a snapshot into io.ErrUnexpectedEOF.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
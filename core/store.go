@@ -6,9 +6,12 @@ import (
 	"github.com/hashicorp/raft"
 	boltdb "github.com/hashicorp/raft-boltdb"
 	"log"
+	"math"
 	"net"
 	"os"
 	"path/filepath"
+	rpcservicepb "raft-grpc-demo/proto"
+	"strconv"
 	"sync"
 	"time"
 )
@@ -20,6 +23,15 @@ const (
 	openTimeout         = 120 * time.Second
 	leaderWaitDelay     = 100 * time.Millisecond
 	appliedWaitDelay    = 100 * time.Millisecond
+	leaseCheckInterval  = 500 * time.Millisecond
+
+	// promoteMaxLag is how many entries a nonvoter may lag behind the
+	// leader log to be promoted.
+	promoteMaxLag = 1024
+
+	// leaseReadClockDrift is taken off the leader lease to tolerate clocks
+	// running at slightly different rates on the nodes.
+	leaseReadClockDrift = 50 * time.Millisecond
 )
 
 var (
@@ -27,16 +39,46 @@ var (
 	// operation.
 	ErrNotLeader = errors.New("not leader")
 
+	// ErrNotStarted is returned by Shutdown when StartRaft never ran.
+	ErrNotStarted = errors.New("store not started")
+
 	// ErrOpenTimeout is returned when the Store does not apply its initial
 	// logs within the specified time.
 	ErrOpenTimeout = errors.New("timeout waiting for initial logs application")
-)
 
-type command struct {
-	Op    string `json:"op,omitempty"`
-	Key   string `json:"key,omitempty"`
-	Value string `json:"value,omitempty"`
-}
+	// ErrEmptyBatch is returned when ApplyBatch is called without operations.
+	ErrEmptyBatch = errors.New("empty batch")
+
+	// ErrLeaseNotFound is returned when a lease does not exist or expired.
+	ErrLeaseNotFound = errors.New("lease not found")
+
+	// ErrInvalidTTL is returned when a ttl is shorter than one second.
+	ErrInvalidTTL = errors.New("ttl must be at least one second")
+
+	// ErrTTLWithLease is returned when a write asks for both a ttl and a
+	// lease. A ttl grants a lease of its own.
+	ErrTTLWithLease = errors.New("ttl and lease cannot be set together")
+
+	// ErrInvalidContinue is returned when a range continuation token is
+	// malformed or does not belong to the requested range.
+	ErrInvalidContinue = errors.New("invalid continuation token")
+
+	// ErrNodeNotFound is returned when a node is not part of the cluster
+	// configuration.
+	ErrNodeNotFound = errors.New("node not found")
+
+	// ErrRemoveLeader is returned when the leader is asked to remove or
+	// demote itself. Its leadership has to be transferred first.
+	ErrRemoveLeader = errors.New("cannot remove the leader, transfer its leadership first")
+
+	// ErrNotCaughtUp is returned when promoting a nonvoter that is too far
+	// behind the leader.
+	ErrNotCaughtUp = errors.New("node has not caught up with the leader")
+
+	// ErrNothingNewToSnapshot is returned by TakeSnapshot when nothing was
+	// applied since the last snapshot.
+	ErrNothingNewToSnapshot = raft.ErrNothingNewToSnapshot
+)
 
 // ConsistencyLevel Consistency Level of the store data
 type ConsistencyLevel int
@@ -45,29 +87,272 @@ const (
 	Default    ConsistencyLevel = iota //Default store level
 	Stale                              //Stale returns value which maybe old
 	Consistent                         //Consistent returns value that all nodes are consistent
+	LeaseRead                          //LeaseRead returns consistent value from the leader, verifying leadership only when its lease expired
+)
+
+// StalenessBound limits how far behind the leader a stale read may be
+type StalenessBound struct {
+	MaxLagEntries uint64        //MaxLagEntries bounds the committed entries not applied yet, 0 means no bound
+	MaxStaleness  time.Duration //MaxStaleness bounds the time since the leader was last heard from, 0 means no bound
+}
+
+// StaleReadError is returned when a node is too far behind the leader to
+// serve a read within a StalenessBound
+type StaleReadError struct {
+	LagEntries uint64
+	Staleness  time.Duration
+}
+
+func (e *StaleReadError) Error() string {
+	if e.Staleness == time.Duration(math.MaxInt64) {
+		return fmt.Sprintf("stale read: %d entries behind, leader never contacted", e.LagEntries)
+	}
+	return fmt.Sprintf("stale read: %d entries behind, leader last contacted %s ago", e.LagEntries, e.Staleness)
+}
+
+// OpType is the kind of write carried by a BatchOp
+type OpType int
+
+const (
+	OpSet    OpType = iota //OpSet writes Value to Key
+	OpDelete               //OpDelete removes Key
+	OpGet                  //OpGet reads Key, only allowed in Store.Txn
 )
 
+// BatchOp is one write of a batch applied by Store.ApplyBatch, or one op
+// of a branch of Store.Txn
+type BatchOp struct {
+	Op    OpType
+	Key   string
+	Value string
+}
+
+// KeyValue is a key together with its revision metadata
+type KeyValue struct {
+	Key            string
+	Value          string
+	CreateRevision uint64 //CreateRevision is the raft index that created the key
+	ModRevision    uint64 //ModRevision is the raft index that last modified the key
+	Version        uint64 //Version counts the writes since the key was created
+	Lease          int64  //Lease is the lease the key is attached to, 0 if none
+}
+
+// WriteOptions are preconditions of Store.SetWithOptions and DeleteWithOptions
+type WriteOptions struct {
+	// PrevRevision, when non zero, requires the key's ModRevision to match
+	PrevRevision uint64
+	// TTL, when set, deletes the key after it elapsed. It is rounded down
+	// to whole seconds and must be at least one second.
+	TTL time.Duration
+	// Lease attaches the key to an existing lease. It cannot be set
+	// together with TTL.
+	Lease int64
+}
+
+// WriteResult reports the outcome of a write
+type WriteResult struct {
+	Succeeded bool
+	Revision  uint64 //Revision is the raft index of the write, 0 if it did not succeed
+}
+
+// RangeOptions select the keys returned by Store.Range
+type RangeOptions struct {
+	Start  string //Start is the first key of the range, inclusive
+	End    string //End is the end of the range, exclusive. Empty means no bound
+	Prefix string //Prefix, when set, selects the keys starting with it instead of Start/End
+
+	Limit     int  //Limit caps the number of keys returned, 0 means no limit
+	KeysOnly  bool //KeysOnly leaves the values out
+	CountOnly bool //CountOnly only returns the number of keys
+
+	Continue string //Continue resumes a range from a previous RangeResult
+}
+
+// RangeResult is the outcome of Store.Range
+type RangeResult struct {
+	KVs      []*KeyValue
+	Count    int    //Count is the number of keys left in the range, only counted with CountOnly
+	More     bool   //More is set when Limit cut the result short
+	Continue string //Continue is the token fetching the next page when More is set
+}
+
+// Member is a server of the raft configuration
+type Member struct {
+	ID       string
+	RaftAddr string
+	GrpcAddr string //GrpcAddr is the service address the node registered in its meta entry
+	Suffrage string //Suffrage is voter, nonvoter or staging
+	Leader   bool
+}
+
+// NodeStatus is the raft progress of a node
+type NodeStatus struct {
+	ID           string
+	State        string //State is follower, candidate, leader or shutdown
+	AppliedIndex uint64
+	LastLogIndex uint64
+}
+
+// SnapshotInfo describes a raft snapshot
+type SnapshotInfo struct {
+	ID    string
+	Index uint64 //Index is the last raft index included in the snapshot
+	Term  uint64
+	Size  int64 //Size is the size of the snapshot in bytes
+}
+
 //Store has basic information of node
 type Store struct {
 	RaftDataDir string
 	RaftAddr    string
 	RaftId      string
-	m           map[string]string
-	mutex       sync.Mutex
-	raft        *raft.Raft
-	logger      *log.Logger
+	// StorageEngine is the engine holding the state machine, EngineMemory
+	// if empty
+	StorageEngine string
+	// SnapshotCodec compresses the snapshots written by this node: none,
+	// flate or gzip. Empty means none.
+	SnapshotCodec string
+	// SnapshotChecksum is the checksum of the snapshots written by this
+	// node: crc32c or sha256. Empty means crc32c.
+	SnapshotChecksum string
+	// SnapshotInterval is how often raft checks whether to take a snapshot.
+	// Zero keeps the raft default.
+	SnapshotInterval time.Duration
+	// SnapshotThreshold is the number of new log entries that trigger a
+	// snapshot. Zero keeps the raft default.
+	SnapshotThreshold uint64
+	// TrailingLogs is the number of log entries left behind a snapshot so
+	// that slow followers can catch up from the log. Zero keeps the raft
+	// default.
+	TrailingLogs uint64
+	// RetainSnapshots is the number of snapshots kept on disk. Zero means
+	// retainSnapshotCount.
+	RetainSnapshots int
+	// HeartbeatTimeout, ElectionTimeout, CommitTimeout, LeaderLeaseTimeout
+	// and MaxAppendEntries tune raft, see raft.Config. Zero keeps the raft
+	// default.
+	HeartbeatTimeout   time.Duration
+	ElectionTimeout    time.Duration
+	CommitTimeout      time.Duration
+	LeaderLeaseTimeout time.Duration
+	MaxAppendEntries   int
+	engine             engine
+	tx                 engineTxn //transaction of the entry being applied
+	leases             map[int64]*leaseEntry
+	events             []Event //changes of the entry being applied
+	mutex              sync.Mutex
+	raft               *raft.Raft
+	snapshots          raft.SnapshotStore
+	logs               *boltdb.BoltStore
+	stable             *boltdb.BoltStore
+	transport          *raft.NetworkTransport
+	shutdownCh         chan struct{} //closed by Shutdown to stop the background goroutines
+	shutdownOnce       sync.Once
+	shutdownErr        error //outcome of the first Shutdown
+	logger             *log.Logger
+
+	// recoveredIndex is the last entry a persistent engine held when the
+	// node started. Those entries are not applied again.
+	recoveredIndex uint64
+
+	leaseMu        sync.Mutex
+	leaseDeadlines map[int64]time.Time //only maintained on the leader
+
+	watches *watchHub
+
+	leaderLeaseMu      sync.Mutex
+	leaderLeaseUntil   time.Time     //reads at LeaseRead skip VerifyLeader until then
+	leaderLeaseTimeout time.Duration //lease granted by a successful VerifyLeader
+	transfers          int           //leadership transfers in flight
+	lastTransfer       time.Time     //start of the last leadership transfer
+
+	termMu        sync.Mutex
+	committedTerm uint64 //latest term this node committed an entry of as leader
 }
 
 func NewStore() *Store {
 	return &Store{
-		m:      make(map[string]string),
-		logger: log.New(os.Stderr, "[store]", log.LstdFlags),
+		engine:         newMemoryEngine(),
+		leases:         make(map[int64]*leaseEntry),
+		leaseDeadlines: make(map[int64]time.Time),
+		watches:        newWatchHub(),
+		shutdownCh:     make(chan struct{}),
+		logger:         log.New(os.Stderr, "[store]", log.LstdFlags),
 	}
 }
 
-func (s *Store) StartRaft(bootstrap bool) error {
+// Validate reports settings of the store that would make StartRaft fail,
+// without touching the data dir.
+func (s *Store) Validate() error {
+	if s.RaftId == "" {
+		return errors.New("raft id is required")
+	}
+	switch s.StorageEngine {
+	case "", EngineMemory, EngineBolt:
+	default:
+		return fmt.Errorf("unknown storage engine %q", s.StorageEngine)
+	}
+	if _, err := snapshotCodec(s.SnapshotCodec); err != nil {
+		return err
+	}
+	if _, err := snapshotChecksum(s.SnapshotChecksum); err != nil {
+		return err
+	}
+	_, err := s.raftConfig()
+	return err
+}
+
+// raftConfig returns the raft configuration of the store: the raft defaults
+// overridden by the settings that are not zero.
+func (s *Store) raftConfig() (*raft.Config, error) {
 	c := raft.DefaultConfig()
 	c.LocalID = raft.ServerID(s.RaftId)
+	if s.HeartbeatTimeout > 0 {
+		c.HeartbeatTimeout = s.HeartbeatTimeout
+	}
+	if s.ElectionTimeout > 0 {
+		c.ElectionTimeout = s.ElectionTimeout
+	}
+	if s.CommitTimeout > 0 {
+		c.CommitTimeout = s.CommitTimeout
+	}
+	if s.LeaderLeaseTimeout > 0 {
+		c.LeaderLeaseTimeout = s.LeaderLeaseTimeout
+	}
+	if s.MaxAppendEntries > 0 {
+		c.MaxAppendEntries = s.MaxAppendEntries
+	}
+	if s.SnapshotInterval > 0 {
+		c.SnapshotInterval = s.SnapshotInterval
+	}
+	if s.SnapshotThreshold > 0 {
+		c.SnapshotThreshold = s.SnapshotThreshold
+	}
+	if s.TrailingLogs > 0 {
+		c.TrailingLogs = s.TrailingLogs
+	}
+	if err := raft.ValidateConfig(c); err != nil {
+		return nil, fmt.Errorf("invalid raft config: %v", err)
+	}
+	if c.LeaderLeaseTimeout <= leaseReadClockDrift {
+		return nil, fmt.Errorf("invalid raft config: LeaderLeaseTimeout must be longer than %s", leaseReadClockDrift)
+	}
+	return c, nil
+}
+
+func (s *Store) StartRaft(bootstrap bool) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	c, err := s.raftConfig()
+	if err != nil {
+		return err
+	}
+	retain := s.RetainSnapshots
+	if retain <= 0 {
+		retain = retainSnapshotCount
+	}
+	s.leaderLeaseTimeout = c.LeaderLeaseTimeout - leaseReadClockDrift
 
 	newNode := !pathExists(filepath.Join(s.RaftDataDir, "logs.dat"))
 
@@ -85,11 +370,19 @@ func (s *Store) StartRaft(bootstrap bool) error {
 	}
 
 	// Snapshot存储压缩后的日志
-	fss, err := raft.NewFileSnapshotStore(s.RaftDataDir, 3, os.Stderr)
+	fss, err := raft.NewFileSnapshotStore(s.RaftDataDir, retain, os.Stderr)
 	if err != nil {
 		return fmt.Errorf(`raft.NewFileSnapshotStore(%q, ...): %v`, s.RaftDataDir, err)
 	}
 
+	s.logs = logdb
+	s.stable = stabledb
+	s.snapshots = fss
+
+	if err := s.openEngine(c, fss); err != nil {
+		return err
+	}
+
 	addr, err := net.ResolveTCPAddr("tcp", s.RaftAddr)
 	if err != nil {
 		return fmt.Errorf(`raft.ResolveTCPAddr %q fail %v`, s.RaftDataDir, err)
@@ -98,6 +391,7 @@ func (s *Store) StartRaft(bootstrap bool) error {
 	if err != nil {
 		return fmt.Errorf(`raft.NewTCPTransport fail %q %v`, s.RaftDataDir, err)
 	}
+	s.transport = transport
 
 	ra, err := raft.NewRaft(c, (*fsm)(s), logdb, stabledb, fss, transport)
 	if err != nil {
@@ -121,28 +415,344 @@ func (s *Store) StartRaft(bootstrap bool) error {
 		}
 	}
 
+	go s.runLeaseExpiry()
+	go s.observeLeaderChanges()
+
+	return nil
+}
+
+// openEngine opens the storage engine selected by StorageEngine. A
+// persistent engine that is not behind the latest snapshot already holds its
+// state, so raft is told not to restore the snapshot on start.
+func (s *Store) openEngine(c *raft.Config, fss raft.SnapshotStore) error {
+	switch s.StorageEngine {
+	case "", EngineMemory:
+		return nil
+	case EngineBolt:
+	default:
+		return fmt.Errorf("unknown storage engine %q", s.StorageEngine)
+	}
+
+	path := filepath.Join(s.RaftDataDir, "fsm.dat")
+	e, err := openBoltEngine(path)
+	if err != nil {
+		return fmt.Errorf("openBoltEngine(%q): %v", path, err)
+	}
+	applied, err := e.appliedIndex()
+	if err != nil {
+		e.close()
+		return fmt.Errorf("read applied index of %q: %v", path, err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.engine = e
+	if err := (*fsm)(s).loadLeases(); err != nil {
+		return fmt.Errorf("load leases from %q: %v", path, err)
+	}
+	if applied == 0 {
+		return nil
+	}
+
+	snaps, err := fss.List()
+	if err != nil {
+		return fmt.Errorf("list snapshots: %v", err)
+	}
+	if len(snaps) > 0 && snaps[0].Index > applied {
+		// The engine is behind the snapshot and is replaced by it.
+		return nil
+	}
+	c.NoSnapshotRestoreOnStart = true
+	s.recoveredIndex = applied
+	// Events up to the recovered index are not replayed to watchers.
+	s.watches.reset()
+	s.logger.Printf("storage engine %s recovered up to index %d", s.StorageEngine, applied)
 	return nil
 }
 
 func (s *Store) consistentRead() error {
+	start := time.Now()
 	future := s.raft.VerifyLeader()
 	if err := future.Error(); err != nil {
 		return err
 	}
 
+	s.extendLeaderLease(start)
+	return nil
+}
+
+// leaseRead serves reads at the LeaseRead level. A quorum acknowledged this
+// node as leader at the start of the last successful VerifyLeader, and
+// followers refuse to vote for another candidate while they hear from a
+// leader, so no other leader can exist until LeaderLeaseTimeout passed.
+// Leadership is only verified again once that lease ran out.
+//
+// Followers do vote for the target of a leadership transfer though, so
+// there is no lease while a transfer is in flight, see transferLeadership.
+func (s *Store) leaseRead() error {
+	s.leaderLeaseMu.Lock()
+	valid := s.transfers == 0 && time.Now().Before(s.leaderLeaseUntil)
+	s.leaderLeaseMu.Unlock()
+	if valid {
+		return nil
+	}
+
+	return s.consistentRead()
+}
+
+// extendLeaderLease extends the leader lease after leadership was confirmed
+// by a round started at start. Rounds started before a leadership transfer
+// grant no lease.
+func (s *Store) extendLeaderLease(start time.Time) {
+	s.leaderLeaseMu.Lock()
+	defer s.leaderLeaseMu.Unlock()
+
+	if s.transfers > 0 || !start.After(s.lastTransfer) {
+		return
+	}
+	if until := start.Add(s.leaderLeaseTimeout); until.After(s.leaderLeaseUntil) {
+		s.leaderLeaseUntil = until
+	}
+}
+
+// transferLeadership runs transfer, a raft leadership transfer, without a
+// leader lease: the lease is dropped first and not granted again until the
+// transfer ended.
+func (s *Store) transferLeadership(transfer func() raft.Future) error {
+	s.leaderLeaseMu.Lock()
+	s.leaderLeaseUntil = time.Time{}
+	s.transfers++
+	s.lastTransfer = time.Now()
+	s.leaderLeaseMu.Unlock()
+
+	defer func() {
+		s.leaderLeaseMu.Lock()
+		s.transfers--
+		s.leaderLeaseMu.Unlock()
+	}()
+	return transfer().Error()
+}
+
+// observeLeaderChanges drops the leader lease whenever the leader changes,
+// so that a lease is never carried over from a previous term.
+func (s *Store) observeLeaderChanges() {
+	ch := make(chan raft.Observation, 1)
+	observer := raft.NewObserver(ch, false, func(o *raft.Observation) bool {
+		_, ok := o.Data.(raft.LeaderObservation)
+		return ok
+	})
+	s.raft.RegisterObserver(observer)
+	defer s.raft.DeregisterObserver(observer)
+
+	for {
+		select {
+		case <-ch:
+			s.leaderLeaseMu.Lock()
+			s.leaderLeaseUntil = time.Time{}
+			s.leaderLeaseMu.Unlock()
+		case <-s.shutdownCh:
+			return
+		}
+	}
+}
+
+// Shutdown stops the node. A leader first hands its leadership over to
+// another voter, so that the cluster does not wait for an election timeout
+// to elect a new one. Then raft is stopped and the stores are closed. The
+// store must not be used afterwards, calling Shutdown again returns the
+// outcome of the first call.
+func (s *Store) Shutdown() error {
+	if s.raft == nil {
+		return ErrNotStarted
+	}
+	s.shutdownOnce.Do(func() {
+		s.shutdownErr = s.shutdown()
+	})
+	return s.shutdownErr
+}
+
+func (s *Store) shutdown() error {
+	if s.raft.State() == raft.Leader && s.hasOtherVoter() {
+		if err := s.transferLeadership(s.raft.LeadershipTransfer); err != nil {
+			// Shutting down anyway, the followers elect a leader once
+			// they stop hearing from this node.
+			s.logger.Printf("leadership transfer fail %v", err)
+		} else {
+			s.logger.Printf("leadership transferred")
+		}
+	}
+
+	close(s.shutdownCh)
+	if err := s.raft.Shutdown().Error(); err != nil {
+		return fmt.Errorf("raft.Shutdown: %v", err)
+	}
+
+	// Everything is closed even if something fails, the first error is
+	// returned.
+	var first error
+	closeErr := func(what string, err error) {
+		if err != nil && first == nil {
+			first = fmt.Errorf("close %s: %v", what, err)
+		}
+	}
+	closeErr("transport", s.transport.Close())
+	closeErr("log store", s.logs.Close())
+	closeErr("stable store", s.stable.Close())
+	s.mutex.Lock()
+	closeErr("storage engine", s.engine.close())
+	s.mutex.Unlock()
+	return first
+}
+
+// hasOtherVoter reports whether another node could take over leadership.
+func (s *Store) hasOtherVoter() bool {
+	f := s.raft.GetConfiguration()
+	if err := f.Error(); err != nil {
+		return false
+	}
+	for _, srv := range f.Configuration().Servers {
+		if srv.Suffrage == raft.Voter && srv.ID != raft.ServerID(s.RaftId) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadIndex returns the commit index of the leader once it confirmed it is
+// still the leader. A follower that applied up to this index can serve a
+// linearizable read from its local state.
+func (s *Store) ReadIndex() (uint64, error) {
+	if s.raft.State() != raft.Leader {
+		return 0, ErrNotLeader
+	}
+
+	if err := s.commitCurrentTerm(); err != nil {
+		return 0, err
+	}
+	// The index must be taken before leadership is confirmed, otherwise a
+	// deposed leader could hand out an index missing newer writes.
+	idx, err := s.commitIndex()
+	if err != nil {
+		return 0, err
+	}
+	if err := s.consistentRead(); err != nil {
+		return 0, err
+	}
+	return idx, nil
+}
+
+// commitCurrentTerm waits until the leader committed an entry of its current
+// term. Until then, the commit index a new leader inherited may miss entries
+// its predecessor committed and acknowledged. A barrier is committed once
+// per term for that, as raft does with its own no-op entry.
+func (s *Store) commitCurrentTerm() error {
+	term, err := s.raftStat("term")
+	if err != nil {
+		return err
+	}
+	s.termMu.Lock()
+	done := s.committedTerm >= term
+	s.termMu.Unlock()
+	if done {
+		return nil
+	}
+
+	if err := s.raft.Barrier(applyTimeout).Error(); err != nil {
+		return err
+	}
+	s.termMu.Lock()
+	if term > s.committedTerm {
+		s.committedTerm = term
+	}
+	s.termMu.Unlock()
 	return nil
 }
 
-func (s *Store) SetMeta(key, value string) error {
-	return s.Set(key, value)
+// commitIndex returns the latest commit index known to this node.
+func (s *Store) commitIndex() (uint64, error) {
+	return s.raftStat("commit_index")
+}
+
+// raftStat returns the numeric raft statistic name, see raft.Raft.Stats
+func (s *Store) raftStat(name string) (uint64, error) {
+	v, err := strconv.ParseUint(s.raft.Stats()[name], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse %s: %v", name, err)
+	}
+	return v, nil
+}
+
+// CheckStaleness returns a *StaleReadError if the local state may be further
+// behind the leader than bound allows. Otherwise stale reads served by this
+// node meet the bound.
+func (s *Store) CheckStaleness(bound StalenessBound) error {
+	now := time.Now()
+	lastContact := now
+	if s.raft.State() != raft.Leader {
+		lastContact = s.raft.LastContact()
+	}
+
+	commit, err := s.commitIndex()
+	if err != nil {
+		return err
+	}
+	var lag uint64
+	if applied := s.raft.AppliedIndex(); commit > applied {
+		lag = commit - applied
+	}
+	return bound.check(lag, lastContact, now)
 }
 
-func (s *Store) GetMeta(key string) (string, error) {
-	return s.Get(key, Stale)
+// check returns a *StaleReadError if a node lag entries behind the commit
+// index, which heard from the leader at lastContact, is out of bound at now.
+// A zero lastContact means the leader was never heard from.
+func (b StalenessBound) check(lag uint64, lastContact, now time.Time) error {
+	staleness := time.Duration(math.MaxInt64)
+	if !lastContact.IsZero() {
+		staleness = now.Sub(lastContact)
+	}
+	if (b.MaxLagEntries > 0 && lag > b.MaxLagEntries) ||
+		(b.MaxStaleness > 0 && staleness > b.MaxStaleness) {
+		return &StaleReadError{LagEntries: lag, Staleness: staleness}
+	}
+	return nil
 }
 
-func (s *Store) DeleteMeta(key string) error {
-	return s.Delete(key)
+// applyCommand encodes c, replicates it through raft and returns the
+// response of fsm.Apply. An error returned by the fsm is passed through.
+func (s *Store) applyCommand(c *rpcservicepb.Command) (interface{}, error) {
+	b, err := encodeCommand(c)
+	if err != nil {
+		return nil, err
+	}
+
+	f := s.raft.Apply(b, raftTimeout)
+	if err := f.Error(); err != nil {
+		return nil, err
+	}
+
+	resp := f.Response()
+	if err, ok := resp.(error); ok {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TakeSnapshot makes raft take a snapshot now, without waiting for
+// SnapshotThreshold, and compacts the log behind it. Snapshots are local to
+// each node, so it can be called on followers too.
+func (s *Store) TakeSnapshot() (*SnapshotInfo, error) {
+	f := s.raft.Snapshot()
+	if err := f.Error(); err != nil {
+		return nil, err
+	}
+	meta, rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	rc.Close()
+	return &SnapshotInfo{ID: meta.ID, Index: meta.Index, Term: meta.Term, Size: meta.Size}, nil
 }
 
 func (s *Store) LeaderAddr() string {
@@ -172,6 +782,10 @@ func (s *Store) WaitForLeader(timeout time.Duration) (string, error) {
 // WaitForAppliedIndex blocks until a given log index has been applied,
 // or the timeout expires.
 func (s *Store) WaitForAppliedIndex(idx uint64, timeout time.Duration) error {
+	if s.raft.AppliedIndex() >= idx {
+		return nil
+	}
+
 	tck := time.NewTicker(appliedWaitDelay)
 	defer tck.Stop()
 	tmr := time.NewTimer(timeout)
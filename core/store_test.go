@@ -0,0 +1,274 @@
+package core
+
+import (
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+)
+
+// startStore starts a single node store with a bolt engine in dir.
+func startStore(t *testing.T, dir, addr string) *Store {
+	s := NewStore()
+	s.RaftId = "n1"
+	s.RaftAddr = addr
+	s.RaftDataDir = dir
+	s.StorageEngine = EngineBolt
+	assert.Nil(t, s.StartRaft(true))
+	_, err := s.WaitForLeader(10 * time.Second)
+	assert.Nil(t, err)
+	return s
+}
+
+func TestShutdown(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	addr := ln.Addr().String()
+	ln.Close()
+
+	assert.Equal(t, ErrNotStarted, NewStore().Shutdown())
+
+	s := startStore(t, dir, addr)
+	assert.Nil(t, s.Set("a", "1"))
+	assert.Nil(t, s.Shutdown())
+	// e.g. a deferred Shutdown after an explicit one
+	assert.Nil(t, s.Shutdown())
+
+	// The data dir and the raft address are released, a new store picks
+	// up where the first one stopped.
+	s = startStore(t, dir, addr)
+	defer s.Shutdown()
+	assert.Nil(t, s.WaitForApplied(10*time.Second))
+	v, err := s.Get("a", Stale)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", v)
+}
+
+func TestTransferLeadership(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	s := startStore(t, dir, "127.0.0.1:0")
+	defer s.Shutdown()
+
+	assert.Nil(t, s.TransferLeadership("n1"))
+	assert.Equal(t, ErrNodeNotFound, s.TransferLeadership("n9"))
+	// there is no other voter to take over
+	assert.NotNil(t, s.TransferLeadership(""))
+}
+
+func TestReadIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	s := startStore(t, dir, "127.0.0.1:0")
+	defer s.Shutdown()
+	assert.Nil(t, s.Set("a", "1"))
+
+	// The first read index of a term commits a barrier of that term.
+	term, err := s.raftStat("term")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), s.committedTerm)
+	idx, err := s.ReadIndex()
+	assert.Nil(t, err)
+	assert.Equal(t, term, s.committedTerm)
+	assert.Equal(t, s.raft.LastIndex(), idx)
+
+	last := s.raft.LastIndex()
+	assert.Nil(t, s.Set("b", "2"))
+	idx, err = s.ReadIndex()
+	assert.Nil(t, err)
+	assert.Equal(t, last+1, idx)
+	assert.Nil(t, s.WaitForAppliedIndex(idx, time.Second))
+}
+
+func TestLeaseDuringTransfer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	s := startStore(t, dir, "127.0.0.1:0")
+	defer s.Shutdown()
+	leased := func() bool {
+		s.leaderLeaseMu.Lock()
+		defer s.leaderLeaseMu.Unlock()
+		return time.Now().Before(s.leaderLeaseUntil)
+	}
+
+	assert.Nil(t, s.leaseRead())
+	assert.True(t, leased())
+	err = s.transferLeadership(func() raft.Future {
+		// The target of the transfer may already lead, a confirmed
+		// leadership grants no lease meanwhile.
+		assert.False(t, leased())
+		assert.Nil(t, s.leaseRead())
+		assert.False(t, leased())
+		return s.raft.LeadershipTransfer()
+	})
+	// there is no other voter to take over
+	assert.NotNil(t, err)
+
+	// Rounds started before the transfer grant no lease either.
+	s.extendLeaderLease(time.Now().Add(-time.Second))
+	assert.False(t, leased())
+	assert.Nil(t, s.leaseRead())
+	assert.True(t, leased())
+}
+
+func TestLeaderLease(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	addr := ln.Addr().String()
+	ln.Close()
+
+	s := startStore(t, dir, addr)
+	defer s.Shutdown()
+	leaseUntil := func(s *Store) time.Time {
+		s.leaderLeaseMu.Lock()
+		defer s.leaderLeaseMu.Unlock()
+		return s.leaderLeaseUntil
+	}
+
+	t.Run("granted by VerifyLeader", func(t *testing.T) {
+		start := time.Now()
+		assert.Nil(t, s.consistentRead())
+		until := leaseUntil(s)
+		assert.False(t, until.Before(start.Add(s.leaderLeaseTimeout)))
+		assert.False(t, until.After(time.Now().Add(s.leaderLeaseTimeout)))
+
+		// Reads within the lease do not verify the leadership again.
+		assert.Nil(t, s.leaseRead())
+		assert.Equal(t, until, leaseUntil(s))
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		s.leaderLeaseMu.Lock()
+		s.leaderLeaseUntil = time.Now().Add(-time.Millisecond)
+		s.leaderLeaseMu.Unlock()
+
+		start := time.Now()
+		assert.Nil(t, s.leaseRead())
+		assert.True(t, leaseUntil(s).After(start))
+	})
+
+	t.Run("dropped on leader change", func(t *testing.T) {
+		dir2, err := ioutil.TempDir("", "store")
+		assert.Nil(t, err)
+		defer os.RemoveAll(dir2)
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.Nil(t, err)
+		addr2 := ln.Addr().String()
+		ln.Close()
+
+		s2 := NewStore()
+		s2.RaftId = "n2"
+		s2.RaftAddr = addr2
+		s2.RaftDataDir = dir2
+		s2.StorageEngine = EngineBolt
+		assert.Nil(t, s2.StartRaft(false))
+		defer s2.Shutdown()
+		assert.Nil(t, s.Join("n2", "", addr2, false))
+		_, err = s2.WaitForLeader(10 * time.Second)
+		assert.Nil(t, err)
+
+		// A lease left over from an earlier term is dropped once n2 takes
+		// over.
+		s2.leaderLeaseMu.Lock()
+		s2.leaderLeaseUntil = time.Now().Add(time.Hour)
+		s2.leaderLeaseMu.Unlock()
+		assert.Nil(t, s.TransferLeadership("n2"))
+		assert.Eventually(t, func() bool {
+			return leaseUntil(s2).IsZero()
+		}, 10*time.Second, 10*time.Millisecond)
+		assert.True(t, leaseUntil(s).IsZero())
+	})
+}
+
+func TestCheckStaleness(t *testing.T) {
+	now := time.Now()
+	bound := StalenessBound{MaxLagEntries: 10, MaxStaleness: time.Second}
+	tests := []struct {
+		name        string
+		bound       StalenessBound
+		lag         uint64
+		lastContact time.Time
+		err         error
+	}{
+		{"within bound", bound, 10, now.Add(-time.Second), nil},
+		{"lagging", bound, 11, now, &StaleReadError{LagEntries: 11}},
+		{"stale", bound, 0, now.Add(-2 * time.Second), &StaleReadError{Staleness: 2 * time.Second}},
+		{"never contacted", bound, 0, time.Time{}, &StaleReadError{Staleness: time.Duration(math.MaxInt64)}},
+		{"no lag bound", StalenessBound{MaxStaleness: time.Second}, 1000, now, nil},
+		{"no staleness bound", StalenessBound{MaxLagEntries: 10}, 0, time.Time{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, tt.bound.check(tt.lag, tt.lastContact, now))
+		})
+	}
+	assert.EqualError(t, &StaleReadError{Staleness: time.Duration(math.MaxInt64)}, "stale read: 0 entries behind, leader never contacted")
+
+	dir, err := ioutil.TempDir("", "store")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// The leader is never stale.
+	s := startStore(t, dir, "127.0.0.1:0")
+	defer s.Shutdown()
+	assert.Nil(t, s.CheckStaleness(StalenessBound{MaxStaleness: time.Nanosecond}))
+}
+
+func TestMembership(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	s := startStore(t, dir, "127.0.0.1:0")
+	defer s.Shutdown()
+	assert.Nil(t, s.SetMeta("n1", "127.0.0.1:51000"))
+
+	members, err := s.Members()
+	assert.Nil(t, err)
+	assert.Equal(t, []Member{{ID: "n1", RaftAddr: s.RaftAddr, GrpcAddr: "127.0.0.1:51000", Suffrage: "voter", Leader: true}}, members)
+
+	assert.Equal(t, ErrRemoveLeader, s.RemoveNode("n1"))
+	assert.Equal(t, ErrRemoveLeader, s.DemoteVoter("n1"))
+	assert.Equal(t, ErrNodeNotFound, s.RemoveNode("n9"))
+	assert.Equal(t, ErrNodeNotFound, s.DemoteVoter("n9"))
+	assert.Equal(t, ErrNodeNotFound, s.Promote("n9", 0))
+	assert.Nil(t, s.Promote("n1", 0))
+
+	t.Run("nonvoter", func(t *testing.T) {
+		// A nonvoter does not count towards the quorum, the single voter
+		// still commits while it is unreachable.
+		assert.Nil(t, s.Join("n2", "127.0.0.1:51001", "127.0.0.1:1", true))
+		members, err := s.Members()
+		assert.Nil(t, err)
+		assert.Len(t, members, 2)
+		assert.Equal(t, Member{ID: "n2", RaftAddr: "127.0.0.1:1", GrpcAddr: "127.0.0.1:51001", Suffrage: "nonvoter"}, members[1])
+
+		assert.Nil(t, s.DemoteVoter("n2"))
+		assert.Nil(t, s.RemoveNode("n2"))
+		members, err = s.Members()
+		assert.Nil(t, err)
+		assert.Len(t, members, 1)
+		v, err := s.GetMeta("n2")
+		assert.Nil(t, err)
+		assert.Equal(t, "", v)
+	})
+}
@@ -0,0 +1,206 @@
+package core
+
+import (
+	"fmt"
+	"github.com/hashicorp/raft"
+	rpcservicepb "raft-grpc-demo/proto"
+	"strings"
+)
+
+// CompareTarget is the property of a key a Compare looks at
+type CompareTarget int
+
+const (
+	CompareValue          CompareTarget = iota //CompareValue compares the value
+	CompareVersion                             //CompareVersion compares the version, 0 if the key does not exist
+	CompareCreateRevision                      //CompareCreateRevision compares the create revision
+	CompareModRevision                         //CompareModRevision compares the mod revision
+	CompareExists                              //CompareExists checks whether the key exists
+)
+
+// CompareResult is the relation a Compare requires
+type CompareResult int
+
+const (
+	CompareEqual CompareResult = iota
+	CompareNotEqual
+	CompareGreater
+	CompareLess
+)
+
+// Compare is a condition on a key evaluated by Store.Txn. CompareExists only
+// supports CompareEqual (the key exists) and CompareNotEqual (it does not).
+type Compare struct {
+	Key    string
+	Target CompareTarget
+	Result CompareResult
+	Value  string //Value is compared for CompareValue
+	Number uint64 //Number is compared for the version and revision targets
+}
+
+// OpResult is the outcome of one op of a transaction
+type OpResult struct {
+	KV      *KeyValue //KV is the key read by an OpGet, nil if it does not exist
+	Deleted bool      //Deleted reports whether an OpDelete removed a key
+}
+
+// TxnResult is the outcome of Store.Txn
+type TxnResult struct {
+	Succeeded bool       //Succeeded reports whether all compares held
+	Revision  uint64     //Revision is the raft index of the transaction
+	Results   []OpResult //Results has one entry per op of the executed branch
+}
+
+//Txn evaluates compares and applies success if they all hold, failure
+//otherwise. Comparisons, writes and reads happen in a single fsm.Apply, so
+//no other write can interleave with the transaction.
+func (s *Store) Txn(compares []Compare, success, failure []BatchOp) (*TxnResult, error) {
+	if s.raft.State() != raft.Leader {
+		return nil, ErrNotLeader
+	}
+
+	c := &rpcservicepb.Command{
+		Op:       rpcservicepb.CommandOp_CMD_TXN,
+		Compares: make([]*rpcservicepb.Command_Compare, 0, len(compares)),
+	}
+	for _, cmp := range compares {
+		c.Compares = append(c.Compares, &rpcservicepb.Command_Compare{
+			Key:    cmp.Key,
+			Target: rpcservicepb.Command_Compare_Target(cmp.Target),
+			Result: rpcservicepb.Command_Compare_Result(cmp.Result),
+			Value:  cmp.Value,
+			Number: cmp.Number,
+		})
+	}
+	var err error
+	if c.Success, err = txnOpsToCommands(success); err != nil {
+		return nil, err
+	}
+	if c.Failure, err = txnOpsToCommands(failure); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.applyCommand(c)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := resp.(*applyResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected fsm response %T", resp)
+	}
+	return &TxnResult{Succeeded: r.succeeded, Revision: r.revision, Results: r.results}, nil
+}
+
+func txnOpsToCommands(ops []BatchOp) ([]*rpcservicepb.Command, error) {
+	cmds := make([]*rpcservicepb.Command, 0, len(ops))
+	for _, op := range ops {
+		switch op.Op {
+		case OpSet:
+			cmds = append(cmds, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: op.Key, Value: op.Value})
+		case OpDelete:
+			cmds = append(cmds, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_DELETE, Key: op.Key})
+		case OpGet:
+			cmds = append(cmds, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_GET, Key: op.Key})
+		default:
+			return nil, fmt.Errorf("unrecognized txn op: %d", op.Op)
+		}
+	}
+	return cmds, nil
+}
+
+func (f *fsm) applyTxn(index uint64, c *rpcservicepb.Command) interface{} {
+	for _, ops := range [][]*rpcservicepb.Command{c.Success, c.Failure} {
+		for _, op := range ops {
+			switch op.Op {
+			case rpcservicepb.CommandOp_CMD_SET, rpcservicepb.CommandOp_CMD_DELETE, rpcservicepb.CommandOp_CMD_GET:
+			default:
+				return fmt.Errorf("unsupported txn op: %s", op.Op)
+			}
+		}
+	}
+
+	succeeded := true
+	for _, cmp := range c.Compares {
+		if !f.evaluate(cmp) {
+			succeeded = false
+			break
+		}
+	}
+	ops := c.Success
+	if !succeeded {
+		ops = c.Failure
+	}
+
+	results := make([]OpResult, 0, len(ops))
+	for _, op := range ops {
+		var r OpResult
+		switch op.Op {
+		case rpcservicepb.CommandOp_CMD_SET:
+			f.put(index, op.Key, op.Value, 0)
+		case rpcservicepb.CommandOp_CMD_DELETE:
+			_, r.Deleted = f.tx.get(op.Key)
+			f.remove(index, op.Key)
+		case rpcservicepb.CommandOp_CMD_GET:
+			if e, ok := f.tx.get(op.Key); ok {
+				r.KV = newKeyValue(op.Key, e, false)
+			}
+		}
+		results = append(results, r)
+	}
+	return &applyResponse{succeeded: succeeded, revision: index, results: results}
+}
+
+// evaluate reports whether cmp holds. The caller must hold the mutex and
+// run inside Apply.
+func (f *fsm) evaluate(cmp *rpcservicepb.Command_Compare) bool {
+	e, ok := f.tx.get(cmp.Key)
+
+	var rel int
+	switch cmp.Target {
+	case rpcservicepb.Command_Compare_VALUE:
+		if !ok {
+			return false
+		}
+		rel = strings.Compare(e.Value, cmp.Value)
+	case rpcservicepb.Command_Compare_VERSION:
+		rel = compareUint(e.Version, cmp.Number)
+	case rpcservicepb.Command_Compare_CREATE_REVISION:
+		rel = compareUint(e.CreateRevision, cmp.Number)
+	case rpcservicepb.Command_Compare_MOD_REVISION:
+		rel = compareUint(e.ModRevision, cmp.Number)
+	case rpcservicepb.Command_Compare_EXISTS:
+		switch cmp.Result {
+		case rpcservicepb.Command_Compare_EQUAL:
+			return ok
+		case rpcservicepb.Command_Compare_NOT_EQUAL:
+			return !ok
+		}
+		return false
+	default:
+		return false
+	}
+
+	switch cmp.Result {
+	case rpcservicepb.Command_Compare_EQUAL:
+		return rel == 0
+	case rpcservicepb.Command_Compare_NOT_EQUAL:
+		return rel != 0
+	case rpcservicepb.Command_Compare_GREATER:
+		return rel > 0
+	case rpcservicepb.Command_Compare_LESS:
+		return rel < 0
+	default:
+		return false
+	}
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,191 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+const (
+	watchHistorySize = 1024
+	watchBufferSize  = 128
+)
+
+var (
+	// ErrCompacted is returned when a watch asks to resume from a revision
+	// that is no longer held in the event history. It is also reported by
+	// the watchers dropped when a snapshot replaces the state: they re-sync
+	// with Range and watch again from the revision read.
+	ErrCompacted = errors.New("requested revision has been compacted")
+
+	// ErrWatchOverflow is reported by a watcher that could not keep up with
+	// the events and was dropped. It can resume from its last revision + 1.
+	ErrWatchOverflow = errors.New("watcher fell behind and was canceled")
+
+	// ErrWatchCanceled is reported by a watcher canceled by its owner.
+	ErrWatchCanceled = errors.New("watch canceled")
+)
+
+// EventType is the kind of change carried by an Event
+type EventType int
+
+const (
+	EventPut    EventType = iota //EventPut is a key being created or updated
+	EventDelete                  //EventDelete is a key being removed
+)
+
+// Event is a change of a key applied by the fsm
+type Event struct {
+	Type     EventType
+	Key      string
+	Value    string
+	Revision uint64 //Revision is the raft index of the change
+}
+
+// watchHub fans events out to watchers and keeps a bounded history of them
+// so that watchers can resume from a past revision.
+type watchHub struct {
+	mu       sync.Mutex
+	history  []Event
+	watchers map[*Watcher]struct{}
+	// firstRevision is the oldest revision whose events are all in history,
+	// 0 until the first entry is applied after a restore.
+	firstRevision uint64
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{
+		watchers:      make(map[*Watcher]struct{}),
+		firstRevision: 1,
+	}
+}
+
+// Watcher receives the events of a key or prefix. Its channel is closed
+// once it is canceled; Err then tells why.
+type Watcher struct {
+	hub    *watchHub
+	key    string
+	prefix bool
+	ch     chan Event
+	err    error
+}
+
+// Events returns the channel the events are delivered on
+func (w *Watcher) Events() <-chan Event {
+	return w.ch
+}
+
+// Err returns why the watcher stopped, nil while it is running
+func (w *Watcher) Err() error {
+	w.hub.mu.Lock()
+	defer w.hub.mu.Unlock()
+	return w.err
+}
+
+// Cancel stops the watcher and closes its channel
+func (w *Watcher) Cancel() {
+	w.hub.mu.Lock()
+	defer w.hub.mu.Unlock()
+	w.hub.drop(w, ErrWatchCanceled)
+}
+
+func (w *Watcher) matches(key string) bool {
+	if w.prefix {
+		return strings.HasPrefix(key, w.key)
+	}
+	return key == w.key
+}
+
+// drop unregisters w. The caller must hold mu.
+func (h *watchHub) drop(w *Watcher, err error) {
+	if _, ok := h.watchers[w]; !ok {
+		return
+	}
+	delete(h.watchers, w)
+	w.err = err
+	close(w.ch)
+}
+
+// watch registers a watcher, first replaying the history from startRevision
+// if it is non zero.
+func (h *watchHub) watch(key string, prefix bool, startRevision uint64) (*Watcher, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var replay []Event
+	if startRevision > 0 {
+		if h.firstRevision == 0 || startRevision < h.firstRevision {
+			return nil, ErrCompacted
+		}
+		for _, ev := range h.history {
+			if ev.Revision >= startRevision {
+				replay = append(replay, ev)
+			}
+		}
+	}
+
+	w := &Watcher{hub: h, key: key, prefix: prefix}
+	w.ch = make(chan Event, len(replay)+watchBufferSize)
+	for _, ev := range replay {
+		if w.matches(ev.Key) {
+			w.ch <- ev
+		}
+	}
+	h.watchers[w] = struct{}{}
+	return w, nil
+}
+
+// publish records events applied at index and hands them to the watchers.
+// It never blocks: watchers whose buffer is full are dropped.
+func (h *watchHub) publish(index uint64, events []Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.firstRevision == 0 {
+		h.firstRevision = index
+	}
+
+	h.history = append(h.history, events...)
+	if n := len(h.history) - watchHistorySize; n > 0 {
+		h.firstRevision = h.history[n-1].Revision + 1
+		h.history = append([]Event(nil), h.history[n:]...)
+	}
+
+	for w := range h.watchers {
+		for _, ev := range events {
+			if !w.matches(ev.Key) {
+				continue
+			}
+			select {
+			case w.ch <- ev:
+			default:
+				h.drop(w, ErrWatchOverflow)
+			}
+			if w.err != nil {
+				break
+			}
+		}
+	}
+}
+
+// reset forgets the history after the fsm state was replaced by a snapshot.
+// The changes between the state the watchers saw and the snapshot are not
+// known, so the watchers are dropped with ErrCompacted.
+func (h *watchHub) reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.history = nil
+	h.firstRevision = 0
+	for w := range h.watchers {
+		h.drop(w, ErrCompacted)
+	}
+}
+
+//Watch returns a watcher receiving the changes of key, or of every key
+//starting with key if prefix is set. A non zero startRevision first replays
+//the changes since that revision. It is served from the local fsm, so it
+//works on followers as well as on the leader.
+func (s *Store) Watch(key string, prefix bool, startRevision uint64) (*Watcher, error) {
+	return s.watches.watch(key, prefix, startRevision)
+}
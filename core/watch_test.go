@@ -0,0 +1,79 @@
+package core
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rpcservicepb "raft-grpc-demo/proto"
+)
+
+func TestWatch(t *testing.T) {
+	t.Run("prefix and resume", func(t *testing.T) {
+		s := NewStore()
+		f := (*fsm)(s)
+		w, err := s.Watch("a/", true, 0)
+		assert.Nil(t, err)
+
+		applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a/1", Value: "x"})
+		applyTo(t, f, 2, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "b/1", Value: "y"})
+		applyTo(t, f, 3, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_DELETE, Key: "a/1"})
+
+		assert.Equal(t, Event{Type: EventPut, Key: "a/1", Value: "x", Revision: 1}, <-w.Events())
+		assert.Equal(t, Event{Type: EventDelete, Key: "a/1", Revision: 3}, <-w.Events())
+		w.Cancel()
+		_, ok := <-w.Events()
+		assert.False(t, ok)
+		assert.Equal(t, ErrWatchCanceled, w.Err())
+
+		resumed, err := s.Watch("", true, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(2), (<-resumed.Events()).Revision)
+		assert.Equal(t, uint64(3), (<-resumed.Events()).Revision)
+	})
+
+	t.Run("compacted after restore", func(t *testing.T) {
+		s := NewStore()
+		f := (*fsm)(s)
+		applyTo(t, f, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "x"})
+
+		s.watches.reset()
+		_, err := s.Watch("a", false, 1)
+		assert.Equal(t, ErrCompacted, err)
+
+		applyTo(t, f, 5, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "y"})
+		_, err = s.Watch("a", false, 4)
+		assert.Equal(t, ErrCompacted, err)
+		w, err := s.Watch("a", false, 5)
+		assert.Nil(t, err)
+		assert.Equal(t, "y", (<-w.Events()).Value)
+	})
+
+	t.Run("restore drops live watchers", func(t *testing.T) {
+		snapshot := (*fsm)(NewStore())
+		applyTo(t, snapshot, 1, &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "a", Value: "snap"})
+
+		s := NewStore()
+		w, err := s.Watch("a", false, 0)
+		assert.Nil(t, err)
+		assert.Nil(t, (*fsm)(s).Restore(ioutil.NopCloser(bytes.NewReader(persist(t, snapshot)))))
+
+		_, ok := <-w.Events()
+		assert.False(t, ok)
+		assert.Equal(t, ErrCompacted, w.Err())
+		assert.Equal(t, "snap", getFrom(t, (*fsm)(s), "a").Value)
+	})
+
+	t.Run("slow watcher is dropped", func(t *testing.T) {
+		s := NewStore()
+		w, err := s.Watch("k", false, 0)
+		assert.Nil(t, err)
+		for i := 1; i <= watchBufferSize+1; i++ {
+			applyTo(t, (*fsm)(s), uint64(i), &rpcservicepb.Command{Op: rpcservicepb.CommandOp_CMD_SET, Key: "k", Value: "v"})
+		}
+		for range w.Events() {
+		}
+		assert.Equal(t, ErrWatchOverflow, w.Err())
+	})
+}
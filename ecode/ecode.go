@@ -9,6 +9,5 @@ var (
 	ServiceUnavailable    = errors.New("service unavailable")
 	TemporaryRedirect     = errors.New("temporary redirect")
 	InternalServerError   = errors.New("internal server error")
-	NoTypeIDError         = errors.New("no type id ")
 	ErrNoAvailableService = errors.New("no service available")
 )
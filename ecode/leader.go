@@ -0,0 +1,42 @@
+package ecode
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReasonNotLeader is the reason of the ErrorInfo detail of NotLeader errors
+const ReasonNotLeader = "NOT_LEADER"
+
+// NotLeader is the error of a follower asked for an RPC only the leader
+// serves. It is a FailedPrecondition status with an ErrorInfo detail whose
+// "leader" metadata is the grpc address of the leader, see LeaderHint.
+func NotLeader(leaderAddr string) error {
+	st := status.New(codes.FailedPrecondition, "not leader, leader at "+leaderAddr)
+	st, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   ReasonNotLeader,
+		Domain:   "raft-grpc-demo",
+		Metadata: map[string]string{"leader": leaderAddr},
+	})
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, "not leader, leader at "+leaderAddr)
+	}
+	return st.Err()
+}
+
+// LeaderHint returns the grpc address of the leader carried by a NotLeader
+// or StaleRead error.
+func LeaderHint(err error) (string, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return "", false
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && (info.Reason == ReasonNotLeader || info.Reason == ReasonStaleRead) {
+			leader := info.Metadata["leader"]
+			return leader, leader != ""
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,39 @@
+package ecode
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReasonStaleRead is the reason of the ErrorInfo detail of StaleRead errors
+const ReasonStaleRead = "STALE_READ"
+
+// StaleRead is the error of a node too far behind the leader to serve a
+// bounded read. Like NotLeader it is a FailedPrecondition status naming the
+// leader to retry against in the "leader" metadata of its ErrorInfo detail,
+// which also carries the "lag_entries" and, if the leader was ever heard
+// from, the "staleness_ms" of the node.
+func StaleRead(leaderAddr, msg string, lagEntries uint64, staleness time.Duration) error {
+	md := map[string]string{
+		"leader":      leaderAddr,
+		"lag_entries": strconv.FormatUint(lagEntries, 10),
+	}
+	if staleness != time.Duration(math.MaxInt64) {
+		md["staleness_ms"] = strconv.FormatInt(staleness.Milliseconds(), 10)
+	}
+	st := status.New(codes.FailedPrecondition, msg+", leader at "+leaderAddr)
+	st, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   ReasonStaleRead,
+		Domain:   "raft-grpc-demo",
+		Metadata: md,
+	})
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, msg+", leader at "+leaderAddr)
+	}
+	return st.Err()
+}
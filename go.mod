@@ -5,9 +5,15 @@ go 1.16
 require (
 	github.com/gogo/protobuf v1.3.2
 	github.com/gojp/goreportcard v0.0.0-20211204091108-18ad6e4f5cbb // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
+	github.com/google/btree v1.0.1
 	github.com/hashicorp/raft v1.3.2
 	github.com/hashicorp/raft-boltdb v0.0.0-20211202195631-7d34b9fb3f42
 	github.com/stretchr/testify v1.7.0
+	go.etcd.io/bbolt v1.3.6
 	golang.org/x/net v0.0.0-20211216030914-fe4d6282115f // indirect
+	golang.org/x/sys v0.0.0-20211015200801-69063c4bb744 // indirect
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013
 	google.golang.org/grpc v1.42.0
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c
 )
@@ -11,96 +11,192 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"raft-grpc-demo/client"
+	"raft-grpc-demo/config"
 	"raft-grpc-demo/core"
 	rpcservicepb "raft-grpc-demo/proto"
 	"raft-grpc-demo/service"
-	"time"
+	"syscall"
 )
 
-var (
-	grpcAddr     = flag.String("svc", "localhost:51000", "service host:port for this node")
-	raftId       = flag.String("id", "", "node id used by Raft")
-	raftDataDir  = flag.String("data", "data/", "raft data dir")
-	raftAddr     = flag.String("raft", "localhost:52000", "raft host:port for this node")
-	joinAddr     = flag.String("join", "", "join address")
-	registerAddr = flag.String("service_join", "localhost:50000", "raft register center port")
-)
+var backupFile = flag.String("backup", "", "backup file read by the restore mode")
 
 func main() {
-	flag.Parse()
+	// raft-demo restore --backup <file> --id <id> --data <dir> --raft <addr>
+	// seeds a fresh data dir from a backup instead of starting the node.
+	restoreMode := len(os.Args) > 1 && os.Args[1] == "restore"
+	args := os.Args[1:]
+	if restoreMode {
+		args = os.Args[2:]
+	}
+	c, err := config.Load(flag.CommandLine, args)
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
 
-	if *raftId == "" {
-		log.Fatalf("raft id is required")
+	s := newStore(c)
+	if err := s.Validate(); err != nil {
+		log.Fatalf("invalid config: %v", err)
 	}
-	os.MkdirAll(*raftDataDir, 0700)
+	os.MkdirAll(c.Node.DataDir, 0700)
 
-	s := core.NewStore()
-	s.RaftAddr = *raftAddr
-	s.RaftId = *raftId
-	s.RaftDataDir = *raftDataDir
+	if restoreMode {
+		restore(c)
+		return
+	}
 
-	if err := s.StartRaft(*joinAddr == ""); err != nil {
+	if err := s.StartRaft(c.Node.JoinAddr == ""); err != nil {
 		log.Fatalf("s.StartRaft: %v", err)
 	}
-	if *joinAddr != "" {
-		if err := join(*joinAddr, *grpcAddr, *raftAddr, *raftId); err != nil {
-			log.Fatalf("failed to join node at %s: %s", *joinAddr, err.Error())
+	if c.Node.JoinAddr != "" {
+		if err := join(c); err != nil {
+			log.Fatalf("failed to join node at %s: %s", c.Node.JoinAddr, err.Error())
 		}
 	} else {
 		log.Println("no join addresses set")
 	}
 
 	// Wait until the store is in full consensus.
-	openTimeout := 120 * time.Second
-	s.WaitForLeader(openTimeout)
-	s.WaitForApplied(openTimeout)
+	s.WaitForLeader(c.Node.OpenTimeout)
+	s.WaitForApplied(c.Node.OpenTimeout)
 
-	if err := s.SetMeta(*raftId, *grpcAddr); err != nil && err != core.ErrNotLeader {
-		// Non-leader errors are OK, since metadata will then be set through
-		// consensus as a result of a join. All other errors indicate a problem.
-		log.Fatalf("failed to SetMeta at %s: %s", *raftId, err.Error())
+	announce := false
+	if err := s.SetMeta(c.Node.ID, c.Node.GrpcAddr); err == core.ErrNotLeader {
+		// The metadata is then set by the leader, announce it once the
+		// service forwards to the leader.
+		announce = true
+	} else if err != nil {
+		log.Fatalf("failed to SetMeta at %s: %s", c.Node.ID, err.Error())
 	}
 
-	if err := service.NewGrpcServerAndStart(*grpcAddr, s); err != nil {
-		log.Panicf("listen to network address %s failed", *grpcAddr)
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(c.GRPC.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(c.GRPC.MaxSendMsgSize),
 	}
-
-	b, err := json.Marshal(map[string]string{"serviceAddr": *grpcAddr})
-	if err != nil {
-		log.Fatalf("json marshal fail %s", err)
+	if c.GRPC.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(uint32(c.GRPC.MaxConcurrentStreams)))
 	}
-	resp, err := http.Post(fmt.Sprintf("http://%s/service_join", *registerAddr), "application-type/json", bytes.NewReader(b))
+	srv, err := service.NewGrpcServerAndStart(c.Node.GrpcAddr, s, service.ForwardMode(c.GRPC.Forward), opts...)
 	if err != nil {
-		log.Fatalf("join service to client fail %s", err)
+		log.Panicf("listen to network address %s failed", c.Node.GrpcAddr)
 	}
-	resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		log.Fatalf("join service to client fail %d", resp.StatusCode)
+	if announce {
+		if err := joinVia(c, c.Node.GrpcAddr); err != nil {
+			log.Printf("failed to announce %s at %s: %s", c.Node.ID, c.Node.GrpcAddr, err.Error())
+		}
+	}
+
+	if err := register(c, "POST"); err != nil {
+		log.Fatalf("join service to client fail %s", err)
 	}
 
 	log.Println("started successfully")
 
 	terminate := make(chan os.Signal, 1)
-	signal.Notify(terminate, os.Interrupt)
+	signal.Notify(terminate, os.Interrupt, syscall.SIGTERM)
 	<-terminate
 	log.Println("exiting")
 
+	// Leave the register center first so that no new requests are routed
+	// here, then drain the running ones before raft goes away.
+	if err := register(c, "DELETE"); err != nil {
+		log.Printf("leave service from client fail %s", err)
+	}
+	srv.Stop(c.Node.ShutdownTimeout)
+	if err := s.Shutdown(); err != nil {
+		log.Fatalf("shutdown fail %v", err)
+	}
+	log.Println("stopped")
+}
+
+func newStore(c *config.Config) *core.Store {
+	s := core.NewStore()
+	s.RaftAddr = c.Node.RaftAddr
+	s.RaftId = c.Node.ID
+	s.RaftDataDir = c.Node.DataDir
+	s.StorageEngine = c.Node.Engine
+	s.HeartbeatTimeout = c.Raft.HeartbeatTimeout
+	s.ElectionTimeout = c.Raft.ElectionTimeout
+	s.CommitTimeout = c.Raft.CommitTimeout
+	s.LeaderLeaseTimeout = c.Raft.LeaderLeaseTimeout
+	s.MaxAppendEntries = c.Raft.MaxAppendEntries
+	s.SnapshotCodec = c.Snapshot.Codec
+	s.SnapshotChecksum = c.Snapshot.Checksum
+	s.SnapshotInterval = c.Snapshot.Interval
+	s.SnapshotThreshold = c.Snapshot.Threshold
+	s.TrailingLogs = c.Snapshot.TrailingLogs
+	s.RetainSnapshots = c.Snapshot.Retain
+	return s
+}
+
+// register announces the grpc address of the node to the register center
+// with POST, or withdraws it with DELETE.
+func register(c *config.Config, method string) error {
+	b, err := json.Marshal(map[string]string{"serviceAddr": c.Node.GrpcAddr})
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: c.Register.Timeout}
+	req, err := http.NewRequest(method, fmt.Sprintf("http://%s/service_join", c.Register.Addr), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application-type/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func restore(c *config.Config) {
+	if *backupFile == "" {
+		log.Fatalf("backup file is required")
+	}
+	f, err := os.Open(*backupFile)
+	if err != nil {
+		log.Fatalf("open backup %s fail %v", *backupFile, err)
+	}
+	defer f.Close()
+
+	index, err := core.RestoreBackup(f, c.Node.DataDir, c.Node.ID, c.Node.RaftAddr)
+	if err != nil {
+		log.Fatalf("restore backup %s fail %v", *backupFile, err)
+	}
+	log.Printf("restored backup at index %d into %s, start the node without --join to serve it", index, c.Node.DataDir)
+}
+
+func join(c *config.Config) error {
+	return joinVia(c, c.Node.JoinAddr)
 }
 
-func join(joinAddr, grpcAddr, raftAddr, nodeID string) error {
+// joinVia sends the join request of the node to the node at addr, which
+// forwards it to the leader. The leader only refreshes the metadata of a
+// node already member, so nodes restarting announce their grpc address this
+// way through their own service.
+func joinVia(c *config.Config, addr string) error {
 	ctx := context.Background()
-	timeCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	timeCtx, cancel := context.WithTimeout(ctx, c.Node.JoinTimeout)
 	defer cancel()
-	cc, err := grpc.DialContext(timeCtx, joinAddr, grpc.WithInsecure(), grpc.WithBlock())
+	// The node joined may be a follower redirecting to the leader
+	redirect := client.NewLeaderRedirect()
+	defer redirect.Close()
+	opts := append(redirect.DialOptions(), grpc.WithInsecure(), grpc.WithBlock())
+	cc, err := grpc.DialContext(timeCtx, addr, opts...)
 	if err != nil {
 		return err
 	}
 	defer cc.Close()
 	rpcClient := rpcservicepb.NewRpcServiceClient(cc)
 	_, err = rpcClient.Join(timeCtx, &rpcservicepb.JoinReq{
-		GrpcAddr: grpcAddr,
-		RaftAddr: raftAddr,
-		NodeID:   nodeID,
+		GrpcAddr: c.Node.GrpcAddr,
+		RaftAddr: c.Node.RaftAddr,
+		NodeID:   c.Node.ID,
+		Nonvoter: c.Node.Nonvoter,
 	})
 	if err != nil {
 		return err
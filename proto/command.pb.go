@@ -0,0 +1,1467 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: command.proto
+
+package rpcservicepb
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CommandOp is the operation carried by a raft log entry.
+type CommandOp int32
+
+const (
+	CommandOp_CMD_UNKNOWN          CommandOp = 0
+	CommandOp_CMD_SET              CommandOp = 1
+	CommandOp_CMD_DELETE           CommandOp = 2
+	CommandOp_CMD_BATCH            CommandOp = 3
+	CommandOp_CMD_COMPARE_AND_SWAP CommandOp = 4
+	CommandOp_CMD_SET_IF_ABSENT    CommandOp = 5
+	CommandOp_CMD_DELETE_IF_VALUE  CommandOp = 6
+	CommandOp_CMD_LEASE_GRANT      CommandOp = 7
+	CommandOp_CMD_LEASE_REVOKE     CommandOp = 8
+	CommandOp_CMD_TXN              CommandOp = 9
+	// CMD_GET only appears as an op of a CMD_TXN.
+	CommandOp_CMD_GET CommandOp = 10
+	// CMD_META_SET and CMD_META_DELETE write the cluster metadata, a
+	// namespace separate from the user keys.
+	CommandOp_CMD_META_SET    CommandOp = 11
+	CommandOp_CMD_META_DELETE CommandOp = 12
+)
+
+var CommandOp_name = map[int32]string{
+	0:  "CMD_UNKNOWN",
+	1:  "CMD_SET",
+	2:  "CMD_DELETE",
+	3:  "CMD_BATCH",
+	4:  "CMD_COMPARE_AND_SWAP",
+	5:  "CMD_SET_IF_ABSENT",
+	6:  "CMD_DELETE_IF_VALUE",
+	7:  "CMD_LEASE_GRANT",
+	8:  "CMD_LEASE_REVOKE",
+	9:  "CMD_TXN",
+	10: "CMD_GET",
+	11: "CMD_META_SET",
+	12: "CMD_META_DELETE",
+}
+
+var CommandOp_value = map[string]int32{
+	"CMD_UNKNOWN":          0,
+	"CMD_SET":              1,
+	"CMD_DELETE":           2,
+	"CMD_BATCH":            3,
+	"CMD_COMPARE_AND_SWAP": 4,
+	"CMD_SET_IF_ABSENT":    5,
+	"CMD_DELETE_IF_VALUE":  6,
+	"CMD_LEASE_GRANT":      7,
+	"CMD_LEASE_REVOKE":     8,
+	"CMD_TXN":              9,
+	"CMD_GET":              10,
+	"CMD_META_SET":         11,
+	"CMD_META_DELETE":      12,
+}
+
+func (x CommandOp) String() string {
+	return proto.EnumName(CommandOp_name, int32(x))
+}
+
+func (CommandOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{0}
+}
+
+type Command_Compare_Target int32
+
+const (
+	Command_Compare_VALUE           Command_Compare_Target = 0
+	Command_Compare_VERSION         Command_Compare_Target = 1
+	Command_Compare_CREATE_REVISION Command_Compare_Target = 2
+	Command_Compare_MOD_REVISION    Command_Compare_Target = 3
+	Command_Compare_EXISTS          Command_Compare_Target = 4
+)
+
+var Command_Compare_Target_name = map[int32]string{
+	0: "VALUE",
+	1: "VERSION",
+	2: "CREATE_REVISION",
+	3: "MOD_REVISION",
+	4: "EXISTS",
+}
+
+var Command_Compare_Target_value = map[string]int32{
+	"VALUE":           0,
+	"VERSION":         1,
+	"CREATE_REVISION": 2,
+	"MOD_REVISION":    3,
+	"EXISTS":          4,
+}
+
+func (x Command_Compare_Target) String() string {
+	return proto.EnumName(Command_Compare_Target_name, int32(x))
+}
+
+func (Command_Compare_Target) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{0, 0, 0}
+}
+
+type Command_Compare_Result int32
+
+const (
+	Command_Compare_EQUAL     Command_Compare_Result = 0
+	Command_Compare_NOT_EQUAL Command_Compare_Result = 1
+	Command_Compare_GREATER   Command_Compare_Result = 2
+	Command_Compare_LESS      Command_Compare_Result = 3
+)
+
+var Command_Compare_Result_name = map[int32]string{
+	0: "EQUAL",
+	1: "NOT_EQUAL",
+	2: "GREATER",
+	3: "LESS",
+}
+
+var Command_Compare_Result_value = map[string]int32{
+	"EQUAL":     0,
+	"NOT_EQUAL": 1,
+	"GREATER":   2,
+	"LESS":      3,
+}
+
+func (x Command_Compare_Result) String() string {
+	return proto.EnumName(Command_Compare_Result_name, int32(x))
+}
+
+func (Command_Compare_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{0, 0, 1}
+}
+
+// Command is the envelope written to the raft log. On disk every entry is
+// prefixed with a single format version byte, see core/command.go.
+type Command struct {
+	Op       CommandOp         `protobuf:"varint,1,opt,name=op,proto3,enum=rpcservicepb.CommandOp" json:"op,omitempty"`
+	Key      string            `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value    string            `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// ops holds the sub commands of a CMD_BATCH, applied atomically.
+	Ops []*Command `protobuf:"bytes,5,rep,name=ops,proto3" json:"ops,omitempty"`
+	// expected is the value a conditional op requires the key to hold.
+	Expected string `protobuf:"bytes,6,opt,name=expected,proto3" json:"expected,omitempty"`
+	// prev_revision, when non zero, makes CMD_SET and CMD_DELETE conditional
+	// on the key having been last modified at that revision.
+	PrevRevision uint64 `protobuf:"varint,7,opt,name=prev_revision,json=prevRevision,proto3" json:"prev_revision,omitempty"`
+	// ttl is the lease ttl in seconds. On CMD_SET it attaches the key to a
+	// new lease whose id is the index of the entry.
+	Ttl int64 `protobuf:"varint,8,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// lease is the lease a CMD_SET attaches the key to, or the lease that
+	// CMD_LEASE_REVOKE revokes.
+	Lease int64 `protobuf:"varint,9,opt,name=lease,proto3" json:"lease,omitempty"`
+	// compares, success and failure make up a CMD_TXN: success is applied
+	// if all compares hold, failure otherwise.
+	Compares []*Command_Compare `protobuf:"bytes,10,rep,name=compares,proto3" json:"compares,omitempty"`
+	Success  []*Command         `protobuf:"bytes,11,rep,name=success,proto3" json:"success,omitempty"`
+	Failure  []*Command         `protobuf:"bytes,12,rep,name=failure,proto3" json:"failure,omitempty"`
+}
+
+func (m *Command) Reset()         { *m = Command{} }
+func (m *Command) String() string { return proto.CompactTextString(m) }
+func (*Command) ProtoMessage()    {}
+func (*Command) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{0}
+}
+func (m *Command) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Command) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Command.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Command) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Command.Merge(m, src)
+}
+func (m *Command) XXX_Size() int {
+	return m.Size()
+}
+func (m *Command) XXX_DiscardUnknown() {
+	xxx_messageInfo_Command.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Command proto.InternalMessageInfo
+
+func (m *Command) GetOp() CommandOp {
+	if m != nil {
+		return m.Op
+	}
+	return CommandOp_CMD_UNKNOWN
+}
+
+func (m *Command) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Command) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Command) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Command) GetOps() []*Command {
+	if m != nil {
+		return m.Ops
+	}
+	return nil
+}
+
+func (m *Command) GetExpected() string {
+	if m != nil {
+		return m.Expected
+	}
+	return ""
+}
+
+func (m *Command) GetPrevRevision() uint64 {
+	if m != nil {
+		return m.PrevRevision
+	}
+	return 0
+}
+
+func (m *Command) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *Command) GetLease() int64 {
+	if m != nil {
+		return m.Lease
+	}
+	return 0
+}
+
+func (m *Command) GetCompares() []*Command_Compare {
+	if m != nil {
+		return m.Compares
+	}
+	return nil
+}
+
+func (m *Command) GetSuccess() []*Command {
+	if m != nil {
+		return m.Success
+	}
+	return nil
+}
+
+func (m *Command) GetFailure() []*Command {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+// Compare is a condition of a CMD_TXN.
+type Command_Compare struct {
+	Key    string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Target Command_Compare_Target `protobuf:"varint,2,opt,name=target,proto3,enum=rpcservicepb.Command_Compare_Target" json:"target,omitempty"`
+	Result Command_Compare_Result `protobuf:"varint,3,opt,name=result,proto3,enum=rpcservicepb.Command_Compare_Result" json:"result,omitempty"`
+	Value  string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Number uint64                 `protobuf:"varint,5,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *Command_Compare) Reset()         { *m = Command_Compare{} }
+func (m *Command_Compare) String() string { return proto.CompactTextString(m) }
+func (*Command_Compare) ProtoMessage()    {}
+func (*Command_Compare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{0, 0}
+}
+func (m *Command_Compare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Command_Compare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Command_Compare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Command_Compare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Command_Compare.Merge(m, src)
+}
+func (m *Command_Compare) XXX_Size() int {
+	return m.Size()
+}
+func (m *Command_Compare) XXX_DiscardUnknown() {
+	xxx_messageInfo_Command_Compare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Command_Compare proto.InternalMessageInfo
+
+func (m *Command_Compare) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Command_Compare) GetTarget() Command_Compare_Target {
+	if m != nil {
+		return m.Target
+	}
+	return Command_Compare_VALUE
+}
+
+func (m *Command_Compare) GetResult() Command_Compare_Result {
+	if m != nil {
+		return m.Result
+	}
+	return Command_Compare_EQUAL
+}
+
+func (m *Command_Compare) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Command_Compare) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("rpcservicepb.CommandOp", CommandOp_name, CommandOp_value)
+	proto.RegisterEnum("rpcservicepb.Command_Compare_Target", Command_Compare_Target_name, Command_Compare_Target_value)
+	proto.RegisterEnum("rpcservicepb.Command_Compare_Result", Command_Compare_Result_name, Command_Compare_Result_value)
+	proto.RegisterType((*Command)(nil), "rpcservicepb.Command")
+	proto.RegisterMapType((map[string]string)(nil), "rpcservicepb.Command.MetadataEntry")
+	proto.RegisterType((*Command_Compare)(nil), "rpcservicepb.Command.Compare")
+}
+
+func init() { proto.RegisterFile("command.proto", fileDescriptor_213c0bb044472049) }
+
+var fileDescriptor_213c0bb044472049 = []byte{
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xda, 0x4a,
+	0x14, 0x65, 0x6c, 0x30, 0x70, 0x81, 0x64, 0xde, 0x24, 0x79, 0x19, 0x45, 0x7a, 0x08, 0x25, 0x4f,
+	0x0a, 0x7a, 0x0b, 0x9e, 0x94, 0x6e, 0x9a, 0xb6, 0x52, 0xe5, 0xc0, 0x34, 0x45, 0x01, 0x93, 0x8e,
+	0x1d, 0x92, 0x9d, 0xe5, 0x38, 0xd3, 0x0a, 0x15, 0xb0, 0x65, 0x1b, 0xd4, 0xfc, 0x45, 0x3f, 0xab,
+	0xcb, 0x6c, 0x2a, 0x75, 0xd7, 0x2a, 0xf9, 0x8f, 0xaa, 0x9a, 0xb1, 0x81, 0x54, 0x42, 0x51, 0x77,
+	0x3e, 0x67, 0xce, 0xb9, 0x73, 0xcf, 0xbd, 0x03, 0x50, 0xf3, 0x83, 0xc9, 0xc4, 0x9b, 0xde, 0xb4,
+	0xc2, 0x28, 0x48, 0x02, 0x52, 0x8d, 0x42, 0x3f, 0x16, 0xd1, 0x7c, 0xe4, 0x8b, 0xf0, 0x7a, 0xff,
+	0xbb, 0x01, 0xc5, 0x76, 0x7a, 0x4e, 0x0e, 0x41, 0x0b, 0x42, 0x8a, 0x1a, 0xa8, 0xb9, 0x71, 0xb4,
+	0xdb, 0x7a, 0x2c, 0x6b, 0x65, 0x92, 0x41, 0xc8, 0xb5, 0x20, 0x24, 0x18, 0xf4, 0x8f, 0xe2, 0x96,
+	0x6a, 0x0d, 0xd4, 0x2c, 0x73, 0xf9, 0x49, 0xb6, 0xa1, 0x30, 0xf7, 0xc6, 0x33, 0x41, 0x75, 0xc5,
+	0xa5, 0x80, 0xbc, 0x86, 0xd2, 0x44, 0x24, 0xde, 0x8d, 0x97, 0x78, 0x34, 0xdf, 0xd0, 0x9b, 0x95,
+	0xa3, 0x83, 0xb5, 0x65, 0x5b, 0xfd, 0x4c, 0xc5, 0xa6, 0x49, 0x74, 0xcb, 0x97, 0x26, 0x72, 0x08,
+	0x7a, 0x10, 0xc6, 0xb4, 0xa0, 0xbc, 0x3b, 0x6b, 0xbd, 0x5c, 0x2a, 0xc8, 0x1e, 0x94, 0xc4, 0xa7,
+	0x50, 0xf8, 0x89, 0xb8, 0xa1, 0x86, 0x6a, 0x61, 0x89, 0xc9, 0x01, 0xd4, 0xc2, 0x48, 0xcc, 0xdd,
+	0x48, 0xcc, 0x47, 0xf1, 0x28, 0x98, 0xd2, 0x62, 0x03, 0x35, 0xf3, 0xbc, 0x2a, 0x49, 0x9e, 0x71,
+	0x32, 0x52, 0x92, 0x8c, 0x69, 0xa9, 0x81, 0x9a, 0x3a, 0x97, 0x9f, 0x32, 0xd2, 0x58, 0x78, 0xb1,
+	0xa0, 0x65, 0xc5, 0xa5, 0x80, 0x1c, 0x43, 0xc9, 0x0f, 0x26, 0xa1, 0x17, 0x89, 0x98, 0x82, 0x6a,
+	0xeb, 0x9f, 0xf5, 0x91, 0xda, 0xa9, 0x8a, 0x2f, 0xe5, 0xe4, 0x7f, 0x28, 0xc6, 0x33, 0xdf, 0x17,
+	0x71, 0x4c, 0x2b, 0x4f, 0x05, 0x5a, 0xa8, 0xa4, 0xe1, 0xbd, 0x37, 0x1a, 0xcf, 0x22, 0x41, 0xab,
+	0x4f, 0x1a, 0x32, 0xd5, 0xde, 0x57, 0x4d, 0x2d, 0x53, 0x5e, 0xb7, 0xd8, 0x11, 0x5a, 0xed, 0xe8,
+	0x15, 0x18, 0x89, 0x17, 0x7d, 0x10, 0x89, 0x5a, 0xdc, 0xc6, 0xd1, 0xbf, 0x4f, 0x36, 0xde, 0x72,
+	0x94, 0x96, 0x67, 0x1e, 0xe9, 0x8e, 0x44, 0x3c, 0x1b, 0x27, 0x54, 0xff, 0x13, 0x37, 0x57, 0x5a,
+	0x9e, 0x79, 0x56, 0xef, 0x23, 0xff, 0xf8, 0x7d, 0xfc, 0x0d, 0xc6, 0x74, 0x36, 0xb9, 0x16, 0x11,
+	0x2d, 0xa8, 0x95, 0x64, 0x68, 0xdf, 0x06, 0x23, 0xbd, 0x9d, 0x94, 0xa1, 0x30, 0x34, 0x7b, 0x17,
+	0x0c, 0xe7, 0x48, 0x05, 0x8a, 0x43, 0xc6, 0xed, 0xee, 0xc0, 0xc2, 0x88, 0x6c, 0xc1, 0x66, 0x9b,
+	0x33, 0xd3, 0x61, 0x2e, 0x67, 0xc3, 0xae, 0x22, 0x35, 0x82, 0xa1, 0xda, 0x1f, 0x74, 0x56, 0x8c,
+	0x4e, 0x00, 0x0c, 0x76, 0xd5, 0xb5, 0x1d, 0x1b, 0xe7, 0xf7, 0x8f, 0xc1, 0x48, 0x9b, 0x92, 0x45,
+	0xd9, 0xbb, 0x0b, 0xb3, 0x87, 0x73, 0xa4, 0x06, 0x65, 0x6b, 0xe0, 0xb8, 0x29, 0x44, 0xf2, 0x8e,
+	0x53, 0x55, 0x96, 0x63, 0x8d, 0x94, 0x20, 0xdf, 0x63, 0xb6, 0x8d, 0xf5, 0xbd, 0x97, 0x50, 0xfb,
+	0xed, 0x85, 0xae, 0x19, 0xee, 0x32, 0xa0, 0xf6, 0x28, 0xe0, 0x0b, 0xed, 0x39, 0xfa, 0xef, 0x27,
+	0x82, 0xf2, 0xf2, 0xe7, 0x43, 0x36, 0xa1, 0xd2, 0xee, 0x77, 0xdc, 0x0b, 0xeb, 0xcc, 0x1a, 0x5c,
+	0x5a, 0x69, 0x2c, 0x49, 0xd8, 0xcc, 0xc1, 0x88, 0x6c, 0x00, 0x48, 0xd0, 0x61, 0x3d, 0xe6, 0x30,
+	0xac, 0xc9, 0xf6, 0x24, 0x3e, 0x31, 0x9d, 0xf6, 0x5b, 0xac, 0x13, 0x0a, 0xdb, 0x12, 0xb6, 0x07,
+	0xfd, 0x73, 0x93, 0x33, 0xd7, 0xb4, 0x3a, 0xae, 0x7d, 0x69, 0x9e, 0xe3, 0x3c, 0xd9, 0x81, 0xbf,
+	0xb2, 0x2a, 0x6e, 0xf7, 0x8d, 0x6b, 0x9e, 0xd8, 0xcc, 0x72, 0x70, 0x81, 0xec, 0xc2, 0xd6, 0xaa,
+	0x9e, 0x3c, 0x49, 0x87, 0x69, 0xa8, 0xf9, 0xf5, 0x3b, 0x6e, 0x8f, 0x99, 0x36, 0x73, 0x4f, 0xb9,
+	0x69, 0x39, 0xb8, 0x48, 0xb6, 0x01, 0xaf, 0x48, 0xce, 0x86, 0x83, 0x33, 0x86, 0x4b, 0x8b, 0x06,
+	0x9d, 0x2b, 0x0b, 0x97, 0x17, 0xe0, 0x94, 0x39, 0x18, 0xe4, 0xbc, 0x25, 0xe8, 0x33, 0xc7, 0x54,
+	0xfd, 0x57, 0x16, 0x65, 0x15, 0x93, 0x85, 0xa8, 0x9e, 0xd0, 0x2f, 0xf7, 0x75, 0x74, 0x77, 0x5f,
+	0x47, 0x3f, 0xee, 0xeb, 0xe8, 0xf3, 0x43, 0x3d, 0x77, 0xf7, 0x50, 0xcf, 0x7d, 0x7b, 0xa8, 0xe7,
+	0xae, 0x0d, 0xf5, 0x8f, 0xf4, 0xec, 0xd7, 0x00, 0x65, 0x20, 0x37, 0xb4, 0xa2, 0x04, 0x00, 0x00,
+}
+
+func (m *Command) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Command) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Command) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failure) > 0 {
+		for iNdEx := len(m.Failure) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failure[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommand(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Success) > 0 {
+		for iNdEx := len(m.Success) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Success[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommand(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Compares) > 0 {
+		for iNdEx := len(m.Compares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Compares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommand(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Lease != 0 {
+		i = encodeVarintCommand(dAtA, i, uint64(m.Lease))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Ttl != 0 {
+		i = encodeVarintCommand(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PrevRevision != 0 {
+		i = encodeVarintCommand(dAtA, i, uint64(m.PrevRevision))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Expected) > 0 {
+		i -= len(m.Expected)
+		copy(dAtA[i:], m.Expected)
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Expected)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommand(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCommand(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCommand(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintCommand(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Command_Compare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Command_Compare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Command_Compare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintCommand(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Result != 0 {
+		i = encodeVarintCommand(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Target != 0 {
+		i = encodeVarintCommand(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCommand(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommand(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Command) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovCommand(uint64(m.Op))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + len(v) + sovCommand(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ops) > 0 {
+		for _, e := range m.Ops {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	l = len(m.Expected)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.PrevRevision != 0 {
+		n += 1 + sovCommand(uint64(m.PrevRevision))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovCommand(uint64(m.Ttl))
+	}
+	if m.Lease != 0 {
+		n += 1 + sovCommand(uint64(m.Lease))
+	}
+	if len(m.Compares) > 0 {
+		for _, e := range m.Compares {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if len(m.Success) > 0 {
+		for _, e := range m.Success {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if len(m.Failure) > 0 {
+		for _, e := range m.Failure {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Command_Compare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.Target != 0 {
+		n += 1 + sovCommand(uint64(m.Target))
+	}
+	if m.Result != 0 {
+		n += 1 + sovCommand(uint64(m.Result))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovCommand(uint64(m.Number))
+	}
+	return n
+}
+
+func sovCommand(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCommand(x uint64) (n int) {
+	return sovCommand(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Command) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= CommandOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommand(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ops = append(m.Ops, &Command{})
+			if err := m.Ops[len(m.Ops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expected = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRevision", wireType)
+			}
+			m.PrevRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			m.Lease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lease |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compares = append(m.Compares, &Command_Compare{})
+			if err := m.Compares[len(m.Compares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Success = append(m.Success, &Command{})
+			if err := m.Success[len(m.Success)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failure = append(m.Failure, &Command{})
+			if err := m.Failure[len(m.Failure)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Command_Compare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Compare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Compare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= Command_Compare_Target(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= Command_Compare_Result(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCommand(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCommand
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCommand
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCommand
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCommand        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCommand          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCommand = fmt.Errorf("proto: unexpected end of group")
+)
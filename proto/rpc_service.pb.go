@@ -26,9 +26,131 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type BatchOp_Type int32
+
+const (
+	BatchOp_SET    BatchOp_Type = 0
+	BatchOp_DELETE BatchOp_Type = 1
+	// GET is only allowed in transactions.
+	BatchOp_GET BatchOp_Type = 2
+)
+
+var BatchOp_Type_name = map[int32]string{
+	0: "SET",
+	1: "DELETE",
+	2: "GET",
+}
+
+var BatchOp_Type_value = map[string]int32{
+	"SET":    0,
+	"DELETE": 1,
+	"GET":    2,
+}
+
+func (x BatchOp_Type) String() string {
+	return proto.EnumName(BatchOp_Type_name, int32(x))
+}
+
+func (BatchOp_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{8, 0}
+}
+
+type Compare_Target int32
+
+const (
+	Compare_VALUE           Compare_Target = 0
+	Compare_VERSION         Compare_Target = 1
+	Compare_CREATE_REVISION Compare_Target = 2
+	Compare_MOD_REVISION    Compare_Target = 3
+	Compare_EXISTS          Compare_Target = 4
+)
+
+var Compare_Target_name = map[int32]string{
+	0: "VALUE",
+	1: "VERSION",
+	2: "CREATE_REVISION",
+	3: "MOD_REVISION",
+	4: "EXISTS",
+}
+
+var Compare_Target_value = map[string]int32{
+	"VALUE":           0,
+	"VERSION":         1,
+	"CREATE_REVISION": 2,
+	"MOD_REVISION":    3,
+	"EXISTS":          4,
+}
+
+func (x Compare_Target) String() string {
+	return proto.EnumName(Compare_Target_name, int32(x))
+}
+
+func (Compare_Target) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{26, 0}
+}
+
+type Compare_Result int32
+
+const (
+	Compare_EQUAL     Compare_Result = 0
+	Compare_NOT_EQUAL Compare_Result = 1
+	Compare_GREATER   Compare_Result = 2
+	Compare_LESS      Compare_Result = 3
+)
+
+var Compare_Result_name = map[int32]string{
+	0: "EQUAL",
+	1: "NOT_EQUAL",
+	2: "GREATER",
+	3: "LESS",
+}
+
+var Compare_Result_value = map[string]int32{
+	"EQUAL":     0,
+	"NOT_EQUAL": 1,
+	"GREATER":   2,
+	"LESS":      3,
+}
+
+func (x Compare_Result) String() string {
+	return proto.EnumName(Compare_Result_name, int32(x))
+}
+
+func (Compare_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{26, 1}
+}
+
+type WatchRsp_EventType int32
+
+const (
+	WatchRsp_PUT    WatchRsp_EventType = 0
+	WatchRsp_DELETE WatchRsp_EventType = 1
+)
+
+var WatchRsp_EventType_name = map[int32]string{
+	0: "PUT",
+	1: "DELETE",
+}
+
+var WatchRsp_EventType_value = map[string]int32{
+	"PUT":    0,
+	"DELETE": 1,
+}
+
+func (x WatchRsp_EventType) String() string {
+	return proto.EnumName(WatchRsp_EventType_name, int32(x))
+}
+
+func (WatchRsp_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{33, 0}
+}
+
 type GetReq struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// maxLagEntries and maxStalenessMs bound reads at the "bounded" level
+	MaxLagEntries  uint64 `protobuf:"varint,3,opt,name=maxLagEntries,proto3" json:"maxLagEntries,omitempty"`
+	MaxStalenessMs int64  `protobuf:"varint,4,opt,name=maxStalenessMs,proto3" json:"maxStalenessMs,omitempty"`
 }
 
 func (m *GetReq) Reset()         { *m = GetReq{} }
@@ -78,8 +200,26 @@ func (m *GetReq) GetLevel() string {
 	return ""
 }
 
+func (m *GetReq) GetMaxLagEntries() uint64 {
+	if m != nil {
+		return m.MaxLagEntries
+	}
+	return 0
+}
+
+func (m *GetReq) GetMaxStalenessMs() int64 {
+	if m != nil {
+		return m.MaxStalenessMs
+	}
+	return 0
+}
+
 type GetRsp struct {
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Value          string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	CreateRevision uint64 `protobuf:"varint,2,opt,name=createRevision,proto3" json:"createRevision,omitempty"`
+	ModRevision    uint64 `protobuf:"varint,3,opt,name=modRevision,proto3" json:"modRevision,omitempty"`
+	Version        uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Lease          int64  `protobuf:"varint,5,opt,name=lease,proto3" json:"lease,omitempty"`
 }
 
 func (m *GetRsp) Reset()         { *m = GetRsp{} }
@@ -122,9 +262,40 @@ func (m *GetRsp) GetValue() string {
 	return ""
 }
 
+func (m *GetRsp) GetCreateRevision() uint64 {
+	if m != nil {
+		return m.CreateRevision
+	}
+	return 0
+}
+
+func (m *GetRsp) GetModRevision() uint64 {
+	if m != nil {
+		return m.ModRevision
+	}
+	return 0
+}
+
+func (m *GetRsp) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetRsp) GetLease() int64 {
+	if m != nil {
+		return m.Lease
+	}
+	return 0
+}
+
 type SetReq struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Key          string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value        string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	PrevRevision uint64 `protobuf:"varint,3,opt,name=prevRevision,proto3" json:"prevRevision,omitempty"`
+	Ttl          int64  `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Lease        int64  `protobuf:"varint,5,opt,name=lease,proto3" json:"lease,omitempty"`
 }
 
 func (m *SetReq) Reset()         { *m = SetReq{} }
@@ -174,7 +345,30 @@ func (m *SetReq) GetValue() string {
 	return ""
 }
 
+func (m *SetReq) GetPrevRevision() uint64 {
+	if m != nil {
+		return m.PrevRevision
+	}
+	return 0
+}
+
+func (m *SetReq) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *SetReq) GetLease() int64 {
+	if m != nil {
+		return m.Lease
+	}
+	return 0
+}
+
 type SetRsp struct {
+	Succeeded bool   `protobuf:"varint,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Revision  uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
 func (m *SetRsp) Reset()         { *m = SetRsp{} }
@@ -210,8 +404,23 @@ func (m *SetRsp) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetRsp proto.InternalMessageInfo
 
+func (m *SetRsp) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
+	}
+	return false
+}
+
+func (m *SetRsp) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
 type DeleteReq struct {
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Key          string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PrevRevision uint64 `protobuf:"varint,2,opt,name=prevRevision,proto3" json:"prevRevision,omitempty"`
 }
 
 func (m *DeleteReq) Reset()         { *m = DeleteReq{} }
@@ -254,7 +463,16 @@ func (m *DeleteReq) GetKey() string {
 	return ""
 }
 
+func (m *DeleteReq) GetPrevRevision() uint64 {
+	if m != nil {
+		return m.PrevRevision
+	}
+	return 0
+}
+
 type DeleteRsp struct {
+	Succeeded bool   `protobuf:"varint,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Revision  uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
 func (m *DeleteRsp) Reset()         { *m = DeleteRsp{} }
@@ -290,10 +508,25 @@ func (m *DeleteRsp) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteRsp proto.InternalMessageInfo
 
+func (m *DeleteRsp) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
+	}
+	return false
+}
+
+func (m *DeleteRsp) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
 type JoinReq struct {
 	GrpcAddr string `protobuf:"bytes,1,opt,name=grpcAddr,proto3" json:"grpcAddr,omitempty"`
 	RaftAddr string `protobuf:"bytes,2,opt,name=raftAddr,proto3" json:"raftAddr,omitempty"`
 	NodeID   string `protobuf:"bytes,3,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Nonvoter bool   `protobuf:"varint,4,opt,name=nonvoter,proto3" json:"nonvoter,omitempty"`
 }
 
 func (m *JoinReq) Reset()         { *m = JoinReq{} }
@@ -350,6 +583,13 @@ func (m *JoinReq) GetNodeID() string {
 	return ""
 }
 
+func (m *JoinReq) GetNonvoter() bool {
+	if m != nil {
+		return m.Nonvoter
+	}
+	return false
+}
+
 type JoinRsp struct {
 }
 
@@ -386,604 +626,10399 @@ func (m *JoinRsp) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JoinRsp proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*GetReq)(nil), "rpcservicepb.GetReq")
-	proto.RegisterType((*GetRsp)(nil), "rpcservicepb.GetRsp")
-	proto.RegisterType((*SetReq)(nil), "rpcservicepb.SetReq")
-	proto.RegisterType((*SetRsp)(nil), "rpcservicepb.SetRsp")
-	proto.RegisterType((*DeleteReq)(nil), "rpcservicepb.DeleteReq")
-	proto.RegisterType((*DeleteRsp)(nil), "rpcservicepb.DeleteRsp")
-	proto.RegisterType((*JoinReq)(nil), "rpcservicepb.JoinReq")
-	proto.RegisterType((*JoinRsp)(nil), "rpcservicepb.JoinRsp")
+type BatchOp struct {
+	Type  BatchOp_Type `protobuf:"varint,1,opt,name=type,proto3,enum=rpcservicepb.BatchOp_Type" json:"type,omitempty"`
+	Key   string       `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value string       `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func init() { proto.RegisterFile("rpc_service.proto", fileDescriptor_eb646182a01d8986) }
-
-var fileDescriptor_eb646182a01d8986 = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xb1, 0x4b, 0xc3, 0x40,
-	0x14, 0xc6, 0x73, 0x8d, 0xc6, 0xe6, 0xe9, 0xa0, 0x47, 0xd5, 0x10, 0xf0, 0x90, 0x9b, 0x9c, 0x82,
-	0x58, 0x70, 0x72, 0x51, 0x0a, 0x45, 0xc7, 0x64, 0x72, 0x92, 0x36, 0x79, 0x4a, 0x31, 0x34, 0xe7,
-	0x5d, 0x0c, 0xf8, 0x5f, 0xf8, 0x67, 0x39, 0x76, 0x74, 0x94, 0x64, 0xf5, 0x8f, 0x90, 0xcb, 0x9d,
-	0xa1, 0xc5, 0xe0, 0x96, 0xef, 0x7d, 0xdf, 0xef, 0x85, 0xf7, 0x71, 0x70, 0x20, 0x45, 0xfa, 0xa0,
-	0x50, 0x56, 0x8b, 0x14, 0x23, 0x21, 0x8b, 0xb2, 0xa0, 0x7b, 0x52, 0xa4, 0x76, 0x22, 0xe6, 0xfc,
-	0x1c, 0xbc, 0x29, 0x96, 0x31, 0xbe, 0xd0, 0x7d, 0x70, 0x9f, 0xf1, 0x2d, 0x20, 0xa7, 0xe4, 0xcc,
-	0x8f, 0xf5, 0x27, 0x1d, 0xc1, 0x76, 0x8e, 0x15, 0xe6, 0xc1, 0xa0, 0x9d, 0x19, 0xc1, 0x99, 0x21,
-	0x94, 0xd0, 0x7e, 0x35, 0xcb, 0x5f, 0xd1, 0x32, 0x46, 0xe8, 0x8d, 0xc9, 0x3f, 0x1b, 0x0d, 0x31,
-	0x58, 0x27, 0x86, 0x86, 0x50, 0x82, 0x9f, 0x80, 0x3f, 0xc1, 0x1c, 0x4b, 0xec, 0xc5, 0xf9, 0x6e,
-	0x67, 0x2b, 0xc1, 0xef, 0x61, 0xe7, 0xae, 0x58, 0x2c, 0x75, 0x32, 0x84, 0xe1, 0x93, 0x14, 0xe9,
-	0x75, 0x96, 0x49, 0x1b, 0xef, 0xb4, 0xf6, 0xe4, 0xec, 0xb1, 0x6c, 0x3d, 0xf3, 0xd7, 0x4e, 0xd3,
-	0x23, 0xf0, 0x96, 0x45, 0x86, 0xb7, 0x93, 0xc0, 0x6d, 0x1d, 0xab, 0xb8, 0x6f, 0x57, 0x2b, 0x71,
-	0xf1, 0x4d, 0x00, 0x62, 0x91, 0x26, 0xa6, 0x30, 0x3a, 0x06, 0x77, 0x8a, 0x25, 0x1d, 0x45, 0xeb,
-	0x25, 0x46, 0xa6, 0xc1, 0xb0, 0x67, 0xaa, 0x04, 0x77, 0x34, 0x94, 0xfc, 0x85, 0x92, 0x5e, 0x28,
-	0xf9, 0x85, 0xae, 0xc0, 0x33, 0xb7, 0xd2, 0xe3, 0xcd, 0x44, 0x57, 0x50, 0xd8, 0x6f, 0xb4, 0xf4,
-	0x25, 0x6c, 0xe9, 0x0b, 0xe8, 0xe1, 0x66, 0xc4, 0x16, 0x16, 0xf6, 0x8d, 0x35, 0x77, 0x13, 0x7c,
-	0xd4, 0x8c, 0xac, 0x6a, 0x46, 0xbe, 0x6a, 0x46, 0xde, 0x1b, 0xe6, 0xac, 0x1a, 0xe6, 0x7c, 0x36,
-	0xcc, 0x99, 0x7b, 0xed, 0xeb, 0x19, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3e, 0xa8, 0xba, 0x75,
-	0x52, 0x02, 0x00, 0x00,
+func (m *BatchOp) Reset()         { *m = BatchOp{} }
+func (m *BatchOp) String() string { return proto.CompactTextString(m) }
+func (*BatchOp) ProtoMessage()    {}
+func (*BatchOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{8}
+}
+func (m *BatchOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOp.Merge(m, src)
+}
+func (m *BatchOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOp.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_BatchOp proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *BatchOp) GetType() BatchOp_Type {
+	if m != nil {
+		return m.Type
+	}
+	return BatchOp_SET
+}
 
-// RpcServiceClient is the client API for RpcService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RpcServiceClient interface {
-	Get(ctx context.Context, in *GetReq, opts ...grpc.CallOption) (*GetRsp, error)
-	Set(ctx context.Context, in *SetReq, opts ...grpc.CallOption) (*SetRsp, error)
-	Delete(ctx context.Context, in *DeleteReq, opts ...grpc.CallOption) (*DeleteRsp, error)
-	Join(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (*JoinRsp, error)
+func (m *BatchOp) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-type rpcServiceClient struct {
-	cc *grpc.ClientConn
+func (m *BatchOp) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func NewRpcServiceClient(cc *grpc.ClientConn) RpcServiceClient {
-	return &rpcServiceClient{cc}
+type BatchReq struct {
+	Ops []*BatchOp `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops,omitempty"`
 }
 
-func (c *rpcServiceClient) Get(ctx context.Context, in *GetReq, opts ...grpc.CallOption) (*GetRsp, error) {
-	out := new(GetRsp)
-	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchReq) Reset()         { *m = BatchReq{} }
+func (m *BatchReq) String() string { return proto.CompactTextString(m) }
+func (*BatchReq) ProtoMessage()    {}
+func (*BatchReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{9}
+}
+func (m *BatchReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *BatchReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchReq.Merge(m, src)
+}
+func (m *BatchReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchReq.DiscardUnknown(m)
 }
 
-func (c *rpcServiceClient) Set(ctx context.Context, in *SetReq, opts ...grpc.CallOption) (*SetRsp, error) {
-	out := new(SetRsp)
-	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Set", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchReq proto.InternalMessageInfo
+
+func (m *BatchReq) GetOps() []*BatchOp {
+	if m != nil {
+		return m.Ops
 	}
-	return out, nil
+	return nil
 }
 
-func (c *rpcServiceClient) Delete(ctx context.Context, in *DeleteReq, opts ...grpc.CallOption) (*DeleteRsp, error) {
-	out := new(DeleteRsp)
-	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BatchRsp struct {
 }
 
-func (c *rpcServiceClient) Join(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (*JoinRsp, error) {
-	out := new(JoinRsp)
-	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Join", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchRsp) Reset()         { *m = BatchRsp{} }
+func (m *BatchRsp) String() string { return proto.CompactTextString(m) }
+func (*BatchRsp) ProtoMessage()    {}
+func (*BatchRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{10}
+}
+func (m *BatchRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// RpcServiceServer is the server API for RpcService service.
-type RpcServiceServer interface {
-	Get(context.Context, *GetReq) (*GetRsp, error)
-	Set(context.Context, *SetReq) (*SetRsp, error)
-	Delete(context.Context, *DeleteReq) (*DeleteRsp, error)
-	Join(context.Context, *JoinReq) (*JoinRsp, error)
+func (m *BatchRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRsp.Merge(m, src)
+}
+func (m *BatchRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRsp.DiscardUnknown(m)
 }
 
-// UnimplementedRpcServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedRpcServiceServer struct {
+var xxx_messageInfo_BatchRsp proto.InternalMessageInfo
+
+type CompareAndSwapReq struct {
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Expected string `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (*UnimplementedRpcServiceServer) Get(ctx context.Context, req *GetReq) (*GetRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *CompareAndSwapReq) Reset()         { *m = CompareAndSwapReq{} }
+func (m *CompareAndSwapReq) String() string { return proto.CompactTextString(m) }
+func (*CompareAndSwapReq) ProtoMessage()    {}
+func (*CompareAndSwapReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{11}
 }
-func (*UnimplementedRpcServiceServer) Set(ctx context.Context, req *SetReq) (*SetRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+func (m *CompareAndSwapReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedRpcServiceServer) Delete(ctx context.Context, req *DeleteReq) (*DeleteRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *CompareAndSwapReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompareAndSwapReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedRpcServiceServer) Join(ctx context.Context, req *JoinReq) (*JoinRsp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Join not implemented")
+func (m *CompareAndSwapReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareAndSwapReq.Merge(m, src)
 }
-
-func RegisterRpcServiceServer(s *grpc.Server, srv RpcServiceServer) {
-	s.RegisterService(&_RpcService_serviceDesc, srv)
+func (m *CompareAndSwapReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompareAndSwapReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareAndSwapReq.DiscardUnknown(m)
 }
 
-func _RpcService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RpcServiceServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcservicepb.RpcService/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).Get(ctx, req.(*GetReq))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_CompareAndSwapReq proto.InternalMessageInfo
 
-func _RpcService_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RpcServiceServer).Set(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcservicepb.RpcService/Set",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).Set(ctx, req.(*SetReq))
+func (m *CompareAndSwapReq) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _RpcService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RpcServiceServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcservicepb.RpcService/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).Delete(ctx, req.(*DeleteReq))
+func (m *CompareAndSwapReq) GetExpected() string {
+	if m != nil {
+		return m.Expected
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _RpcService_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RpcServiceServer).Join(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcservicepb.RpcService/Join",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).Join(ctx, req.(*JoinReq))
+func (m *CompareAndSwapReq) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _RpcService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rpcservicepb.RpcService",
-	HandlerType: (*RpcServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _RpcService_Get_Handler,
-		},
-		{
-			MethodName: "Set",
-			Handler:    _RpcService_Set_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _RpcService_Delete_Handler,
-		},
-		{
-			MethodName: "Join",
-			Handler:    _RpcService_Join_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "rpc_service.proto",
+type CompareAndSwapRsp struct {
+	Succeeded bool `protobuf:"varint,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
 }
 
-func (m *GetReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CompareAndSwapRsp) Reset()         { *m = CompareAndSwapRsp{} }
+func (m *CompareAndSwapRsp) String() string { return proto.CompactTextString(m) }
+func (*CompareAndSwapRsp) ProtoMessage()    {}
+func (*CompareAndSwapRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{12}
 }
-
-func (m *GetReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CompareAndSwapRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Level) > 0 {
-		i -= len(m.Level)
-		copy(dAtA[i:], m.Level)
-		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Level)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (m *CompareAndSwapRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompareAndSwapRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CompareAndSwapRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompareAndSwapRsp.Merge(m, src)
+}
+func (m *CompareAndSwapRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompareAndSwapRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompareAndSwapRsp.DiscardUnknown(m)
 }
 
-func (m *GetRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CompareAndSwapRsp proto.InternalMessageInfo
+
+func (m *CompareAndSwapRsp) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GetRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetIfAbsentReq struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *GetRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *SetIfAbsentReq) Reset()         { *m = SetIfAbsentReq{} }
+func (m *SetIfAbsentReq) String() string { return proto.CompactTextString(m) }
+func (*SetIfAbsentReq) ProtoMessage()    {}
+func (*SetIfAbsentReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{13}
 }
-
-func (m *SetReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetIfAbsentReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetIfAbsentReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetIfAbsentReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *SetReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetIfAbsentReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetIfAbsentReq.Merge(m, src)
+}
+func (m *SetIfAbsentReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetIfAbsentReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetIfAbsentReq.DiscardUnknown(m)
 }
 
-func (m *SetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_SetIfAbsentReq proto.InternalMessageInfo
+
+func (m *SetIfAbsentReq) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SetRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetIfAbsentReq) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SetRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetIfAbsentRsp struct {
+	Succeeded bool `protobuf:"varint,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
 }
 
-func (m *SetRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SetIfAbsentRsp) Reset()         { *m = SetIfAbsentRsp{} }
+func (m *SetIfAbsentRsp) String() string { return proto.CompactTextString(m) }
+func (*SetIfAbsentRsp) ProtoMessage()    {}
+func (*SetIfAbsentRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{14}
+}
+func (m *SetIfAbsentRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetIfAbsentRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetIfAbsentRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetIfAbsentRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetIfAbsentRsp.Merge(m, src)
+}
+func (m *SetIfAbsentRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetIfAbsentRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetIfAbsentRsp.DiscardUnknown(m)
 }
 
-func (m *DeleteReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetIfAbsentRsp proto.InternalMessageInfo
+
+func (m *SetIfAbsentRsp) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *DeleteReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeleteIfValueReq struct {
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Expected string `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
 }
 
-func (m *DeleteReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (m *DeleteIfValueReq) Reset()         { *m = DeleteIfValueReq{} }
+func (m *DeleteIfValueReq) String() string { return proto.CompactTextString(m) }
+func (*DeleteIfValueReq) ProtoMessage()    {}
+func (*DeleteIfValueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{15}
+}
+func (m *DeleteIfValueReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteIfValueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteIfValueReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeleteIfValueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteIfValueReq.Merge(m, src)
+}
+func (m *DeleteIfValueReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteIfValueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteIfValueReq.DiscardUnknown(m)
 }
 
-func (m *DeleteRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteIfValueReq proto.InternalMessageInfo
+
+func (m *DeleteIfValueReq) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DeleteRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteIfValueReq) GetExpected() string {
+	if m != nil {
+		return m.Expected
+	}
+	return ""
 }
 
-func (m *DeleteRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type DeleteIfValueRsp struct {
+	Succeeded bool `protobuf:"varint,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
 }
 
-func (m *JoinReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeleteIfValueRsp) Reset()         { *m = DeleteIfValueRsp{} }
+func (m *DeleteIfValueRsp) String() string { return proto.CompactTextString(m) }
+func (*DeleteIfValueRsp) ProtoMessage()    {}
+func (*DeleteIfValueRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{16}
+}
+func (m *DeleteIfValueRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteIfValueRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteIfValueRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *JoinReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteIfValueRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteIfValueRsp.Merge(m, src)
+}
+func (m *DeleteIfValueRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteIfValueRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteIfValueRsp.DiscardUnknown(m)
 }
 
-func (m *JoinReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NodeID) > 0 {
-		i -= len(m.NodeID)
-		copy(dAtA[i:], m.NodeID)
-		i = encodeVarintRpcService(dAtA, i, uint64(len(m.NodeID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RaftAddr) > 0 {
-		i -= len(m.RaftAddr)
-		copy(dAtA[i:], m.RaftAddr)
-		i = encodeVarintRpcService(dAtA, i, uint64(len(m.RaftAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GrpcAddr) > 0 {
-		i -= len(m.GrpcAddr)
-		copy(dAtA[i:], m.GrpcAddr)
-		i = encodeVarintRpcService(dAtA, i, uint64(len(m.GrpcAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_DeleteIfValueRsp proto.InternalMessageInfo
+
+func (m *DeleteIfValueRsp) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *JoinRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type LeaseGrantReq struct {
+	Ttl int64 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (m *LeaseGrantReq) Reset()         { *m = LeaseGrantReq{} }
+func (m *LeaseGrantReq) String() string { return proto.CompactTextString(m) }
+func (*LeaseGrantReq) ProtoMessage()    {}
+func (*LeaseGrantReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{17}
+}
+func (m *LeaseGrantReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseGrantReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseGrantReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *LeaseGrantReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseGrantReq.Merge(m, src)
+}
+func (m *LeaseGrantReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseGrantReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseGrantReq.DiscardUnknown(m)
 }
 
-func (m *JoinRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_LeaseGrantReq proto.InternalMessageInfo
+
+func (m *LeaseGrantReq) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
 }
 
-func (m *JoinRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type LeaseGrantRsp struct {
+	Id  int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ttl int64 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func encodeVarintRpcService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRpcService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *LeaseGrantRsp) Reset()         { *m = LeaseGrantRsp{} }
+func (m *LeaseGrantRsp) String() string { return proto.CompactTextString(m) }
+func (*LeaseGrantRsp) ProtoMessage()    {}
+func (*LeaseGrantRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{18}
 }
-func (m *GetReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovRpcService(uint64(l))
-	}
-	l = len(m.Level)
-	if l > 0 {
-		n += 1 + l + sovRpcService(uint64(l))
+func (m *LeaseGrantRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseGrantRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseGrantRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *LeaseGrantRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseGrantRsp.Merge(m, src)
+}
+func (m *LeaseGrantRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseGrantRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseGrantRsp.DiscardUnknown(m)
 }
 
-func (m *GetRsp) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_LeaseGrantRsp proto.InternalMessageInfo
+
+func (m *LeaseGrantRsp) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovRpcService(uint64(l))
+	return 0
+}
+
+func (m *LeaseGrantRsp) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
 	}
-	return n
+	return 0
 }
 
-func (m *SetReq) Size() (n int) {
-	if m == nil {
-		return 0
+type LeaseKeepAliveReq struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *LeaseKeepAliveReq) Reset()         { *m = LeaseKeepAliveReq{} }
+func (m *LeaseKeepAliveReq) String() string { return proto.CompactTextString(m) }
+func (*LeaseKeepAliveReq) ProtoMessage()    {}
+func (*LeaseKeepAliveReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{19}
+}
+func (m *LeaseKeepAliveReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseKeepAliveReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseKeepAliveReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovRpcService(uint64(l))
+}
+func (m *LeaseKeepAliveReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseKeepAliveReq.Merge(m, src)
+}
+func (m *LeaseKeepAliveReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseKeepAliveReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseKeepAliveReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseKeepAliveReq proto.InternalMessageInfo
+
+func (m *LeaseKeepAliveReq) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovRpcService(uint64(l))
+	return 0
+}
+
+type LeaseKeepAliveRsp struct {
+	Id  int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ttl int64 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (m *LeaseKeepAliveRsp) Reset()         { *m = LeaseKeepAliveRsp{} }
+func (m *LeaseKeepAliveRsp) String() string { return proto.CompactTextString(m) }
+func (*LeaseKeepAliveRsp) ProtoMessage()    {}
+func (*LeaseKeepAliveRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{20}
+}
+func (m *LeaseKeepAliveRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseKeepAliveRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseKeepAliveRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *LeaseKeepAliveRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseKeepAliveRsp.Merge(m, src)
+}
+func (m *LeaseKeepAliveRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseKeepAliveRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseKeepAliveRsp.DiscardUnknown(m)
 }
 
-func (m *SetRsp) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_LeaseKeepAliveRsp proto.InternalMessageInfo
+
+func (m *LeaseKeepAliveRsp) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *DeleteReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LeaseKeepAliveRsp) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
 	}
-	var l int
+	return 0
+}
+
+type LeaseRevokeReq struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *LeaseRevokeReq) Reset()         { *m = LeaseRevokeReq{} }
+func (m *LeaseRevokeReq) String() string { return proto.CompactTextString(m) }
+func (*LeaseRevokeReq) ProtoMessage()    {}
+func (*LeaseRevokeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{21}
+}
+func (m *LeaseRevokeReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseRevokeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseRevokeReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeaseRevokeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseRevokeReq.Merge(m, src)
+}
+func (m *LeaseRevokeReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseRevokeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseRevokeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseRevokeReq proto.InternalMessageInfo
+
+func (m *LeaseRevokeReq) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type LeaseRevokeRsp struct {
+}
+
+func (m *LeaseRevokeRsp) Reset()         { *m = LeaseRevokeRsp{} }
+func (m *LeaseRevokeRsp) String() string { return proto.CompactTextString(m) }
+func (*LeaseRevokeRsp) ProtoMessage()    {}
+func (*LeaseRevokeRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{22}
+}
+func (m *LeaseRevokeRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseRevokeRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseRevokeRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeaseRevokeRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseRevokeRsp.Merge(m, src)
+}
+func (m *LeaseRevokeRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseRevokeRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseRevokeRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseRevokeRsp proto.InternalMessageInfo
+
+type KeyValue struct {
+	Key            string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value          string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CreateRevision uint64 `protobuf:"varint,3,opt,name=createRevision,proto3" json:"createRevision,omitempty"`
+	ModRevision    uint64 `protobuf:"varint,4,opt,name=modRevision,proto3" json:"modRevision,omitempty"`
+	Version        uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Lease          int64  `protobuf:"varint,6,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (m *KeyValue) Reset()         { *m = KeyValue{} }
+func (m *KeyValue) String() string { return proto.CompactTextString(m) }
+func (*KeyValue) ProtoMessage()    {}
+func (*KeyValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{23}
+}
+func (m *KeyValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyValue.Merge(m, src)
+}
+func (m *KeyValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyValue proto.InternalMessageInfo
+
+func (m *KeyValue) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *KeyValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *KeyValue) GetCreateRevision() uint64 {
+	if m != nil {
+		return m.CreateRevision
+	}
+	return 0
+}
+
+func (m *KeyValue) GetModRevision() uint64 {
+	if m != nil {
+		return m.ModRevision
+	}
+	return 0
+}
+
+func (m *KeyValue) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *KeyValue) GetLease() int64 {
+	if m != nil {
+		return m.Lease
+	}
+	return 0
+}
+
+type RangeReq struct {
+	Start          string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End            string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Prefix         string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit          int64  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	KeysOnly       bool   `protobuf:"varint,5,opt,name=keysOnly,proto3" json:"keysOnly,omitempty"`
+	CountOnly      bool   `protobuf:"varint,6,opt,name=countOnly,proto3" json:"countOnly,omitempty"`
+	Continuation   string `protobuf:"bytes,7,opt,name=continuation,proto3" json:"continuation,omitempty"`
+	Level          string `protobuf:"bytes,8,opt,name=level,proto3" json:"level,omitempty"`
+	MaxLagEntries  uint64 `protobuf:"varint,9,opt,name=maxLagEntries,proto3" json:"maxLagEntries,omitempty"`
+	MaxStalenessMs int64  `protobuf:"varint,10,opt,name=maxStalenessMs,proto3" json:"maxStalenessMs,omitempty"`
+}
+
+func (m *RangeReq) Reset()         { *m = RangeReq{} }
+func (m *RangeReq) String() string { return proto.CompactTextString(m) }
+func (*RangeReq) ProtoMessage()    {}
+func (*RangeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{24}
+}
+func (m *RangeReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeReq.Merge(m, src)
+}
+func (m *RangeReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeReq proto.InternalMessageInfo
+
+func (m *RangeReq) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *RangeReq) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+func (m *RangeReq) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *RangeReq) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RangeReq) GetKeysOnly() bool {
+	if m != nil {
+		return m.KeysOnly
+	}
+	return false
+}
+
+func (m *RangeReq) GetCountOnly() bool {
+	if m != nil {
+		return m.CountOnly
+	}
+	return false
+}
+
+func (m *RangeReq) GetContinuation() string {
+	if m != nil {
+		return m.Continuation
+	}
+	return ""
+}
+
+func (m *RangeReq) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *RangeReq) GetMaxLagEntries() uint64 {
+	if m != nil {
+		return m.MaxLagEntries
+	}
+	return 0
+}
+
+func (m *RangeReq) GetMaxStalenessMs() int64 {
+	if m != nil {
+		return m.MaxStalenessMs
+	}
+	return 0
+}
+
+type RangeRsp struct {
+	Kvs          []*KeyValue `protobuf:"bytes,1,rep,name=kvs,proto3" json:"kvs,omitempty"`
+	Count        int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	More         bool        `protobuf:"varint,3,opt,name=more,proto3" json:"more,omitempty"`
+	Continuation string      `protobuf:"bytes,4,opt,name=continuation,proto3" json:"continuation,omitempty"`
+}
+
+func (m *RangeRsp) Reset()         { *m = RangeRsp{} }
+func (m *RangeRsp) String() string { return proto.CompactTextString(m) }
+func (*RangeRsp) ProtoMessage()    {}
+func (*RangeRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{25}
+}
+func (m *RangeRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeRsp.Merge(m, src)
+}
+func (m *RangeRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeRsp proto.InternalMessageInfo
+
+func (m *RangeRsp) GetKvs() []*KeyValue {
+	if m != nil {
+		return m.Kvs
+	}
+	return nil
+}
+
+func (m *RangeRsp) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *RangeRsp) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *RangeRsp) GetContinuation() string {
+	if m != nil {
+		return m.Continuation
+	}
+	return ""
+}
+
+type Compare struct {
+	Key    string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Target Compare_Target `protobuf:"varint,2,opt,name=target,proto3,enum=rpcservicepb.Compare_Target" json:"target,omitempty"`
+	Result Compare_Result `protobuf:"varint,3,opt,name=result,proto3,enum=rpcservicepb.Compare_Result" json:"result,omitempty"`
+	Value  string         `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Number uint64         `protobuf:"varint,5,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *Compare) Reset()         { *m = Compare{} }
+func (m *Compare) String() string { return proto.CompactTextString(m) }
+func (*Compare) ProtoMessage()    {}
+func (*Compare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{26}
+}
+func (m *Compare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Compare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Compare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Compare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Compare.Merge(m, src)
+}
+func (m *Compare) XXX_Size() int {
+	return m.Size()
+}
+func (m *Compare) XXX_DiscardUnknown() {
+	xxx_messageInfo_Compare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Compare proto.InternalMessageInfo
+
+func (m *Compare) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Compare) GetTarget() Compare_Target {
+	if m != nil {
+		return m.Target
+	}
+	return Compare_VALUE
+}
+
+func (m *Compare) GetResult() Compare_Result {
+	if m != nil {
+		return m.Result
+	}
+	return Compare_EQUAL
+}
+
+func (m *Compare) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Compare) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+type TxnReq struct {
+	Compares []*Compare `protobuf:"bytes,1,rep,name=compares,proto3" json:"compares,omitempty"`
+	Success  []*BatchOp `protobuf:"bytes,2,rep,name=success,proto3" json:"success,omitempty"`
+	Failure  []*BatchOp `protobuf:"bytes,3,rep,name=failure,proto3" json:"failure,omitempty"`
+}
+
+func (m *TxnReq) Reset()         { *m = TxnReq{} }
+func (m *TxnReq) String() string { return proto.CompactTextString(m) }
+func (*TxnReq) ProtoMessage()    {}
+func (*TxnReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{27}
+}
+func (m *TxnReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnReq.Merge(m, src)
+}
+func (m *TxnReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnReq proto.InternalMessageInfo
+
+func (m *TxnReq) GetCompares() []*Compare {
+	if m != nil {
+		return m.Compares
+	}
+	return nil
+}
+
+func (m *TxnReq) GetSuccess() []*BatchOp {
+	if m != nil {
+		return m.Success
+	}
+	return nil
+}
+
+func (m *TxnReq) GetFailure() []*BatchOp {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+type TxnOpResult struct {
+	Kv      *KeyValue `protobuf:"bytes,1,opt,name=kv,proto3" json:"kv,omitempty"`
+	Deleted bool      `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *TxnOpResult) Reset()         { *m = TxnOpResult{} }
+func (m *TxnOpResult) String() string { return proto.CompactTextString(m) }
+func (*TxnOpResult) ProtoMessage()    {}
+func (*TxnOpResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{28}
+}
+func (m *TxnOpResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnOpResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnOpResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnOpResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnOpResult.Merge(m, src)
+}
+func (m *TxnOpResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnOpResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnOpResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnOpResult proto.InternalMessageInfo
+
+func (m *TxnOpResult) GetKv() *KeyValue {
+	if m != nil {
+		return m.Kv
+	}
+	return nil
+}
+
+func (m *TxnOpResult) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+type TxnRsp struct {
+	Succeeded bool           `protobuf:"varint,1,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Revision  uint64         `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Results   []*TxnOpResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *TxnRsp) Reset()         { *m = TxnRsp{} }
+func (m *TxnRsp) String() string { return proto.CompactTextString(m) }
+func (*TxnRsp) ProtoMessage()    {}
+func (*TxnRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{29}
+}
+func (m *TxnRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnRsp.Merge(m, src)
+}
+func (m *TxnRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxnRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnRsp proto.InternalMessageInfo
+
+func (m *TxnRsp) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
+	}
+	return false
+}
+
+func (m *TxnRsp) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *TxnRsp) GetResults() []*TxnOpResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ReadIndexReq struct {
+}
+
+func (m *ReadIndexReq) Reset()         { *m = ReadIndexReq{} }
+func (m *ReadIndexReq) String() string { return proto.CompactTextString(m) }
+func (*ReadIndexReq) ProtoMessage()    {}
+func (*ReadIndexReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{30}
+}
+func (m *ReadIndexReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadIndexReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadIndexReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadIndexReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadIndexReq.Merge(m, src)
+}
+func (m *ReadIndexReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadIndexReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadIndexReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadIndexReq proto.InternalMessageInfo
+
+type ReadIndexRsp struct {
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *ReadIndexRsp) Reset()         { *m = ReadIndexRsp{} }
+func (m *ReadIndexRsp) String() string { return proto.CompactTextString(m) }
+func (*ReadIndexRsp) ProtoMessage()    {}
+func (*ReadIndexRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{31}
+}
+func (m *ReadIndexRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadIndexRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadIndexRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadIndexRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadIndexRsp.Merge(m, src)
+}
+func (m *ReadIndexRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadIndexRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadIndexRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadIndexRsp proto.InternalMessageInfo
+
+func (m *ReadIndexRsp) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type WatchReq struct {
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Prefix        bool   `protobuf:"varint,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	StartRevision uint64 `protobuf:"varint,3,opt,name=startRevision,proto3" json:"startRevision,omitempty"`
+}
+
+func (m *WatchReq) Reset()         { *m = WatchReq{} }
+func (m *WatchReq) String() string { return proto.CompactTextString(m) }
+func (*WatchReq) ProtoMessage()    {}
+func (*WatchReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{32}
+}
+func (m *WatchReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchReq.Merge(m, src)
+}
+func (m *WatchReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchReq proto.InternalMessageInfo
+
+func (m *WatchReq) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *WatchReq) GetPrefix() bool {
+	if m != nil {
+		return m.Prefix
+	}
+	return false
+}
+
+func (m *WatchReq) GetStartRevision() uint64 {
+	if m != nil {
+		return m.StartRevision
+	}
+	return 0
+}
+
+type WatchRsp struct {
+	Type     WatchRsp_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=rpcservicepb.WatchRsp_EventType" json:"type,omitempty"`
+	Key      string             `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value    string             `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Revision uint64             `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (m *WatchRsp) Reset()         { *m = WatchRsp{} }
+func (m *WatchRsp) String() string { return proto.CompactTextString(m) }
+func (*WatchRsp) ProtoMessage()    {}
+func (*WatchRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{33}
+}
+func (m *WatchRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRsp.Merge(m, src)
+}
+func (m *WatchRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRsp proto.InternalMessageInfo
+
+func (m *WatchRsp) GetType() WatchRsp_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return WatchRsp_PUT
+}
+
+func (m *WatchRsp) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *WatchRsp) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *WatchRsp) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+type SnapshotReq struct {
+}
+
+func (m *SnapshotReq) Reset()         { *m = SnapshotReq{} }
+func (m *SnapshotReq) String() string { return proto.CompactTextString(m) }
+func (*SnapshotReq) ProtoMessage()    {}
+func (*SnapshotReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{34}
+}
+func (m *SnapshotReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotReq.Merge(m, src)
+}
+func (m *SnapshotReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotReq proto.InternalMessageInfo
+
+type SnapshotRsp struct {
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Term  uint64 `protobuf:"varint,3,opt,name=term,proto3" json:"term,omitempty"`
+	Size_ int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *SnapshotRsp) Reset()         { *m = SnapshotRsp{} }
+func (m *SnapshotRsp) String() string { return proto.CompactTextString(m) }
+func (*SnapshotRsp) ProtoMessage()    {}
+func (*SnapshotRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{35}
+}
+func (m *SnapshotRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotRsp.Merge(m, src)
+}
+func (m *SnapshotRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotRsp proto.InternalMessageInfo
+
+func (m *SnapshotRsp) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SnapshotRsp) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *SnapshotRsp) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *SnapshotRsp) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+type BackupReq struct {
+}
+
+func (m *BackupReq) Reset()         { *m = BackupReq{} }
+func (m *BackupReq) String() string { return proto.CompactTextString(m) }
+func (*BackupReq) ProtoMessage()    {}
+func (*BackupReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{36}
+}
+func (m *BackupReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupReq.Merge(m, src)
+}
+func (m *BackupReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupReq proto.InternalMessageInfo
+
+type BackupRsp struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *BackupRsp) Reset()         { *m = BackupRsp{} }
+func (m *BackupRsp) String() string { return proto.CompactTextString(m) }
+func (*BackupRsp) ProtoMessage()    {}
+func (*BackupRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{37}
+}
+func (m *BackupRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRsp.Merge(m, src)
+}
+func (m *BackupRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupRsp proto.InternalMessageInfo
+
+func (m *BackupRsp) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// TransferLeadershipReq hands the leadership over to nodeID, or to the most
+// up to date voter if nodeID is empty
+type TransferLeadershipReq struct {
+	NodeID string `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+}
+
+func (m *TransferLeadershipReq) Reset()         { *m = TransferLeadershipReq{} }
+func (m *TransferLeadershipReq) String() string { return proto.CompactTextString(m) }
+func (*TransferLeadershipReq) ProtoMessage()    {}
+func (*TransferLeadershipReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{38}
+}
+func (m *TransferLeadershipReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLeadershipReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLeadershipReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferLeadershipReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLeadershipReq.Merge(m, src)
+}
+func (m *TransferLeadershipReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLeadershipReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLeadershipReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferLeadershipReq proto.InternalMessageInfo
+
+func (m *TransferLeadershipReq) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+type TransferLeadershipRsp struct {
+}
+
+func (m *TransferLeadershipRsp) Reset()         { *m = TransferLeadershipRsp{} }
+func (m *TransferLeadershipRsp) String() string { return proto.CompactTextString(m) }
+func (*TransferLeadershipRsp) ProtoMessage()    {}
+func (*TransferLeadershipRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{39}
+}
+func (m *TransferLeadershipRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLeadershipRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLeadershipRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferLeadershipRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLeadershipRsp.Merge(m, src)
+}
+func (m *TransferLeadershipRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLeadershipRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLeadershipRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferLeadershipRsp proto.InternalMessageInfo
+
+type RemoveNodeReq struct {
+	NodeID string `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+}
+
+func (m *RemoveNodeReq) Reset()         { *m = RemoveNodeReq{} }
+func (m *RemoveNodeReq) String() string { return proto.CompactTextString(m) }
+func (*RemoveNodeReq) ProtoMessage()    {}
+func (*RemoveNodeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{40}
+}
+func (m *RemoveNodeReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveNodeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveNodeReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveNodeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveNodeReq.Merge(m, src)
+}
+func (m *RemoveNodeReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveNodeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveNodeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveNodeReq proto.InternalMessageInfo
+
+func (m *RemoveNodeReq) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+type RemoveNodeRsp struct {
+}
+
+func (m *RemoveNodeRsp) Reset()         { *m = RemoveNodeRsp{} }
+func (m *RemoveNodeRsp) String() string { return proto.CompactTextString(m) }
+func (*RemoveNodeRsp) ProtoMessage()    {}
+func (*RemoveNodeRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{41}
+}
+func (m *RemoveNodeRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveNodeRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveNodeRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveNodeRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveNodeRsp.Merge(m, src)
+}
+func (m *RemoveNodeRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveNodeRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveNodeRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveNodeRsp proto.InternalMessageInfo
+
+type DemoteVoterReq struct {
+	NodeID string `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+}
+
+func (m *DemoteVoterReq) Reset()         { *m = DemoteVoterReq{} }
+func (m *DemoteVoterReq) String() string { return proto.CompactTextString(m) }
+func (*DemoteVoterReq) ProtoMessage()    {}
+func (*DemoteVoterReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{42}
+}
+func (m *DemoteVoterReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DemoteVoterReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DemoteVoterReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DemoteVoterReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemoteVoterReq.Merge(m, src)
+}
+func (m *DemoteVoterReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DemoteVoterReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemoteVoterReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DemoteVoterReq proto.InternalMessageInfo
+
+func (m *DemoteVoterReq) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+type DemoteVoterRsp struct {
+}
+
+func (m *DemoteVoterRsp) Reset()         { *m = DemoteVoterRsp{} }
+func (m *DemoteVoterRsp) String() string { return proto.CompactTextString(m) }
+func (*DemoteVoterRsp) ProtoMessage()    {}
+func (*DemoteVoterRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{43}
+}
+func (m *DemoteVoterRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DemoteVoterRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DemoteVoterRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DemoteVoterRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemoteVoterRsp.Merge(m, src)
+}
+func (m *DemoteVoterRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *DemoteVoterRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemoteVoterRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DemoteVoterRsp proto.InternalMessageInfo
+
+// PromoteReq turns the nonvoter nodeID into a voter once it caught up with
+// the leader
+type PromoteReq struct {
+	NodeID string `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+}
+
+func (m *PromoteReq) Reset()         { *m = PromoteReq{} }
+func (m *PromoteReq) String() string { return proto.CompactTextString(m) }
+func (*PromoteReq) ProtoMessage()    {}
+func (*PromoteReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{44}
+}
+func (m *PromoteReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoteReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromoteReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PromoteReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteReq.Merge(m, src)
+}
+func (m *PromoteReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromoteReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoteReq proto.InternalMessageInfo
+
+func (m *PromoteReq) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+type PromoteRsp struct {
+}
+
+func (m *PromoteRsp) Reset()         { *m = PromoteRsp{} }
+func (m *PromoteRsp) String() string { return proto.CompactTextString(m) }
+func (*PromoteRsp) ProtoMessage()    {}
+func (*PromoteRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{45}
+}
+func (m *PromoteRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoteRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromoteRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PromoteRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteRsp.Merge(m, src)
+}
+func (m *PromoteRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromoteRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoteRsp proto.InternalMessageInfo
+
+type StatusReq struct {
+}
+
+func (m *StatusReq) Reset()         { *m = StatusReq{} }
+func (m *StatusReq) String() string { return proto.CompactTextString(m) }
+func (*StatusReq) ProtoMessage()    {}
+func (*StatusReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{46}
+}
+func (m *StatusReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusReq.Merge(m, src)
+}
+func (m *StatusReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusReq proto.InternalMessageInfo
+
+// StatusRsp is the raft progress of the node serving it
+type StatusRsp struct {
+	NodeID       string `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	State        string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	AppliedIndex uint64 `protobuf:"varint,3,opt,name=appliedIndex,proto3" json:"appliedIndex,omitempty"`
+	LastLogIndex uint64 `protobuf:"varint,4,opt,name=lastLogIndex,proto3" json:"lastLogIndex,omitempty"`
+}
+
+func (m *StatusRsp) Reset()         { *m = StatusRsp{} }
+func (m *StatusRsp) String() string { return proto.CompactTextString(m) }
+func (*StatusRsp) ProtoMessage()    {}
+func (*StatusRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{47}
+}
+func (m *StatusRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusRsp.Merge(m, src)
+}
+func (m *StatusRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusRsp proto.InternalMessageInfo
+
+func (m *StatusRsp) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *StatusRsp) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *StatusRsp) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
+	}
+	return 0
+}
+
+func (m *StatusRsp) GetLastLogIndex() uint64 {
+	if m != nil {
+		return m.LastLogIndex
+	}
+	return 0
+}
+
+type ListMembersReq struct {
+}
+
+func (m *ListMembersReq) Reset()         { *m = ListMembersReq{} }
+func (m *ListMembersReq) String() string { return proto.CompactTextString(m) }
+func (*ListMembersReq) ProtoMessage()    {}
+func (*ListMembersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{48}
+}
+func (m *ListMembersReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListMembersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListMembersReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListMembersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMembersReq.Merge(m, src)
+}
+func (m *ListMembersReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListMembersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMembersReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMembersReq proto.InternalMessageInfo
+
+// Member is a server of the raft configuration
+type Member struct {
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RaftAddr string `protobuf:"bytes,2,opt,name=raftAddr,proto3" json:"raftAddr,omitempty"`
+	GrpcAddr string `protobuf:"bytes,3,opt,name=grpcAddr,proto3" json:"grpcAddr,omitempty"`
+	Suffrage string `protobuf:"bytes,4,opt,name=suffrage,proto3" json:"suffrage,omitempty"`
+	Leader   bool   `protobuf:"varint,5,opt,name=leader,proto3" json:"leader,omitempty"`
+}
+
+func (m *Member) Reset()         { *m = Member{} }
+func (m *Member) String() string { return proto.CompactTextString(m) }
+func (*Member) ProtoMessage()    {}
+func (*Member) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{49}
+}
+func (m *Member) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Member.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Member) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Member.Merge(m, src)
+}
+func (m *Member) XXX_Size() int {
+	return m.Size()
+}
+func (m *Member) XXX_DiscardUnknown() {
+	xxx_messageInfo_Member.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Member proto.InternalMessageInfo
+
+func (m *Member) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Member) GetRaftAddr() string {
+	if m != nil {
+		return m.RaftAddr
+	}
+	return ""
+}
+
+func (m *Member) GetGrpcAddr() string {
+	if m != nil {
+		return m.GrpcAddr
+	}
+	return ""
+}
+
+func (m *Member) GetSuffrage() string {
+	if m != nil {
+		return m.Suffrage
+	}
+	return ""
+}
+
+func (m *Member) GetLeader() bool {
+	if m != nil {
+		return m.Leader
+	}
+	return false
+}
+
+type ListMembersRsp struct {
+	Members []*Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *ListMembersRsp) Reset()         { *m = ListMembersRsp{} }
+func (m *ListMembersRsp) String() string { return proto.CompactTextString(m) }
+func (*ListMembersRsp) ProtoMessage()    {}
+func (*ListMembersRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb646182a01d8986, []int{50}
+}
+func (m *ListMembersRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListMembersRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListMembersRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListMembersRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMembersRsp.Merge(m, src)
+}
+func (m *ListMembersRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListMembersRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMembersRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMembersRsp proto.InternalMessageInfo
+
+func (m *ListMembersRsp) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("rpcservicepb.BatchOp_Type", BatchOp_Type_name, BatchOp_Type_value)
+	proto.RegisterEnum("rpcservicepb.Compare_Target", Compare_Target_name, Compare_Target_value)
+	proto.RegisterEnum("rpcservicepb.Compare_Result", Compare_Result_name, Compare_Result_value)
+	proto.RegisterEnum("rpcservicepb.WatchRsp_EventType", WatchRsp_EventType_name, WatchRsp_EventType_value)
+	proto.RegisterType((*GetReq)(nil), "rpcservicepb.GetReq")
+	proto.RegisterType((*GetRsp)(nil), "rpcservicepb.GetRsp")
+	proto.RegisterType((*SetReq)(nil), "rpcservicepb.SetReq")
+	proto.RegisterType((*SetRsp)(nil), "rpcservicepb.SetRsp")
+	proto.RegisterType((*DeleteReq)(nil), "rpcservicepb.DeleteReq")
+	proto.RegisterType((*DeleteRsp)(nil), "rpcservicepb.DeleteRsp")
+	proto.RegisterType((*JoinReq)(nil), "rpcservicepb.JoinReq")
+	proto.RegisterType((*JoinRsp)(nil), "rpcservicepb.JoinRsp")
+	proto.RegisterType((*BatchOp)(nil), "rpcservicepb.BatchOp")
+	proto.RegisterType((*BatchReq)(nil), "rpcservicepb.BatchReq")
+	proto.RegisterType((*BatchRsp)(nil), "rpcservicepb.BatchRsp")
+	proto.RegisterType((*CompareAndSwapReq)(nil), "rpcservicepb.CompareAndSwapReq")
+	proto.RegisterType((*CompareAndSwapRsp)(nil), "rpcservicepb.CompareAndSwapRsp")
+	proto.RegisterType((*SetIfAbsentReq)(nil), "rpcservicepb.SetIfAbsentReq")
+	proto.RegisterType((*SetIfAbsentRsp)(nil), "rpcservicepb.SetIfAbsentRsp")
+	proto.RegisterType((*DeleteIfValueReq)(nil), "rpcservicepb.DeleteIfValueReq")
+	proto.RegisterType((*DeleteIfValueRsp)(nil), "rpcservicepb.DeleteIfValueRsp")
+	proto.RegisterType((*LeaseGrantReq)(nil), "rpcservicepb.LeaseGrantReq")
+	proto.RegisterType((*LeaseGrantRsp)(nil), "rpcservicepb.LeaseGrantRsp")
+	proto.RegisterType((*LeaseKeepAliveReq)(nil), "rpcservicepb.LeaseKeepAliveReq")
+	proto.RegisterType((*LeaseKeepAliveRsp)(nil), "rpcservicepb.LeaseKeepAliveRsp")
+	proto.RegisterType((*LeaseRevokeReq)(nil), "rpcservicepb.LeaseRevokeReq")
+	proto.RegisterType((*LeaseRevokeRsp)(nil), "rpcservicepb.LeaseRevokeRsp")
+	proto.RegisterType((*KeyValue)(nil), "rpcservicepb.KeyValue")
+	proto.RegisterType((*RangeReq)(nil), "rpcservicepb.RangeReq")
+	proto.RegisterType((*RangeRsp)(nil), "rpcservicepb.RangeRsp")
+	proto.RegisterType((*Compare)(nil), "rpcservicepb.Compare")
+	proto.RegisterType((*TxnReq)(nil), "rpcservicepb.TxnReq")
+	proto.RegisterType((*TxnOpResult)(nil), "rpcservicepb.TxnOpResult")
+	proto.RegisterType((*TxnRsp)(nil), "rpcservicepb.TxnRsp")
+	proto.RegisterType((*ReadIndexReq)(nil), "rpcservicepb.ReadIndexReq")
+	proto.RegisterType((*ReadIndexRsp)(nil), "rpcservicepb.ReadIndexRsp")
+	proto.RegisterType((*WatchReq)(nil), "rpcservicepb.WatchReq")
+	proto.RegisterType((*WatchRsp)(nil), "rpcservicepb.WatchRsp")
+	proto.RegisterType((*SnapshotReq)(nil), "rpcservicepb.SnapshotReq")
+	proto.RegisterType((*SnapshotRsp)(nil), "rpcservicepb.SnapshotRsp")
+	proto.RegisterType((*BackupReq)(nil), "rpcservicepb.BackupReq")
+	proto.RegisterType((*BackupRsp)(nil), "rpcservicepb.BackupRsp")
+	proto.RegisterType((*TransferLeadershipReq)(nil), "rpcservicepb.TransferLeadershipReq")
+	proto.RegisterType((*TransferLeadershipRsp)(nil), "rpcservicepb.TransferLeadershipRsp")
+	proto.RegisterType((*RemoveNodeReq)(nil), "rpcservicepb.RemoveNodeReq")
+	proto.RegisterType((*RemoveNodeRsp)(nil), "rpcservicepb.RemoveNodeRsp")
+	proto.RegisterType((*DemoteVoterReq)(nil), "rpcservicepb.DemoteVoterReq")
+	proto.RegisterType((*DemoteVoterRsp)(nil), "rpcservicepb.DemoteVoterRsp")
+	proto.RegisterType((*PromoteReq)(nil), "rpcservicepb.PromoteReq")
+	proto.RegisterType((*PromoteRsp)(nil), "rpcservicepb.PromoteRsp")
+	proto.RegisterType((*StatusReq)(nil), "rpcservicepb.StatusReq")
+	proto.RegisterType((*StatusRsp)(nil), "rpcservicepb.StatusRsp")
+	proto.RegisterType((*ListMembersReq)(nil), "rpcservicepb.ListMembersReq")
+	proto.RegisterType((*Member)(nil), "rpcservicepb.Member")
+	proto.RegisterType((*ListMembersRsp)(nil), "rpcservicepb.ListMembersRsp")
+}
+
+func init() { proto.RegisterFile("rpc_service.proto", fileDescriptor_eb646182a01d8986) }
+
+var fileDescriptor_eb646182a01d8986 = []byte{
+	// 1799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x41, 0x6f, 0xdb, 0xc8,
+	0x15, 0x36, 0x29, 0x59, 0xa2, 0x9e, 0x6d, 0xad, 0x32, 0xcd, 0x66, 0x55, 0x36, 0xf0, 0xba, 0xb3,
+	0xc1, 0xae, 0x4f, 0xde, 0x4d, 0xb2, 0x2d, 0xba, 0x68, 0xd1, 0xae, 0xbd, 0x26, 0x5c, 0x37, 0x4a,
+	0xbc, 0x3b, 0x54, 0xec, 0xa2, 0x3d, 0xa4, 0x8c, 0x38, 0x72, 0x08, 0x4b, 0xe4, 0x2c, 0x67, 0xa4,
+	0x95, 0x8b, 0xa2, 0x40, 0x8b, 0x02, 0xbd, 0xf6, 0xd8, 0x4b, 0x2f, 0x3d, 0xf4, 0xd4, 0xbf, 0x51,
+	0xb4, 0xc7, 0x3d, 0xf6, 0x58, 0x24, 0x7f, 0xa4, 0x98, 0x19, 0x92, 0x22, 0x29, 0x8a, 0x71, 0x90,
+	0x1b, 0xdf, 0x9b, 0xf7, 0xde, 0xbc, 0xf7, 0xe6, 0xcd, 0xfb, 0xe6, 0x11, 0x6e, 0xc5, 0x6c, 0xf4,
+	0x8c, 0xd3, 0x78, 0x1e, 0x8c, 0xe8, 0x01, 0x8b, 0x23, 0x11, 0xa1, 0xed, 0x98, 0x8d, 0x12, 0x0e,
+	0x7b, 0x8e, 0x7f, 0x0f, 0xad, 0x13, 0x2a, 0x08, 0xfd, 0x1a, 0xf5, 0xa0, 0x71, 0x45, 0xaf, 0xfb,
+	0xc6, 0x9e, 0xb1, 0xdf, 0x21, 0xf2, 0x13, 0xdd, 0x86, 0xcd, 0x09, 0x9d, 0xd3, 0x49, 0xdf, 0x54,
+	0x3c, 0x4d, 0xa0, 0x7b, 0xb0, 0x33, 0xf5, 0x16, 0x03, 0xef, 0xd2, 0x09, 0x45, 0x1c, 0x50, 0xde,
+	0x6f, 0xec, 0x19, 0xfb, 0x4d, 0x52, 0x64, 0xa2, 0x0f, 0xa1, 0x3b, 0xf5, 0x16, 0xae, 0xf0, 0x26,
+	0x34, 0xa4, 0x9c, 0x3f, 0xe6, 0xfd, 0xe6, 0x9e, 0xb1, 0xdf, 0x20, 0x25, 0x2e, 0xfe, 0xab, 0xa1,
+	0x1d, 0xe0, 0x4c, 0x6e, 0x37, 0xf7, 0x26, 0x33, 0x9a, 0xb8, 0xa0, 0x09, 0x69, 0x68, 0x14, 0x53,
+	0x4f, 0x50, 0x42, 0xe7, 0x01, 0x0f, 0xa2, 0x50, 0x79, 0xd3, 0x24, 0x25, 0x2e, 0xda, 0x83, 0xad,
+	0x69, 0xe4, 0x67, 0x42, 0xda, 0xa9, 0x3c, 0x0b, 0xf5, 0xa1, 0x3d, 0xa7, 0xb1, 0x5a, 0x6d, 0xaa,
+	0xd5, 0x94, 0xd4, 0x81, 0x7a, 0x9c, 0xf6, 0x37, 0x95, 0x8f, 0x9a, 0xc0, 0xbf, 0x83, 0x96, 0x5b,
+	0x93, 0x1a, 0xed, 0xab, 0x99, 0xf7, 0x15, 0xc3, 0x36, 0x8b, 0xe9, 0xbc, 0xe4, 0x44, 0x81, 0x27,
+	0x6d, 0x09, 0x31, 0x49, 0xb2, 0x21, 0x3f, 0xd7, 0xec, 0x7e, 0xa4, 0x77, 0xe7, 0x0c, 0xdd, 0x85,
+	0x0e, 0x9f, 0x8d, 0x46, 0x94, 0xfa, 0xd4, 0x57, 0x3e, 0x58, 0x64, 0xc9, 0x40, 0x36, 0x58, 0x71,
+	0x31, 0x33, 0x19, 0x8d, 0x0f, 0xa1, 0x73, 0x4c, 0x27, 0x54, 0xd0, 0xea, 0x20, 0xca, 0xee, 0x9a,
+	0xab, 0xee, 0x62, 0x27, 0x33, 0xf1, 0x56, 0x9e, 0xcc, 0xa0, 0xfd, 0x8b, 0x28, 0x08, 0xa5, 0x1f,
+	0x36, 0x58, 0x97, 0x31, 0x1b, 0x1d, 0xfa, 0x7e, 0x9c, 0x38, 0x93, 0xd1, 0xca, 0x84, 0x37, 0x16,
+	0x6a, 0x4d, 0x67, 0x36, 0xa3, 0xd1, 0x1d, 0x68, 0x85, 0x91, 0x4f, 0x4f, 0x8f, 0x55, 0x5a, 0x3b,
+	0x24, 0xa1, 0xa4, 0x4e, 0x18, 0x85, 0xf3, 0x48, 0xd0, 0x58, 0x65, 0xd5, 0x22, 0x19, 0x8d, 0x3b,
+	0xc9, 0xb6, 0x9c, 0xe1, 0x3f, 0x1b, 0xd0, 0x3e, 0xf2, 0xc4, 0xe8, 0xc5, 0x19, 0x43, 0x07, 0xd0,
+	0x14, 0xd7, 0x4c, 0x17, 0x5a, 0xf7, 0x81, 0x7d, 0x90, 0xbf, 0x11, 0x07, 0x89, 0xd0, 0xc1, 0xf0,
+	0x9a, 0x51, 0xa2, 0xe4, 0xd2, 0xd4, 0x99, 0x15, 0xe7, 0xdf, 0xc8, 0x9d, 0x3f, 0xbe, 0x07, 0x4d,
+	0xa9, 0x85, 0xda, 0xd0, 0x70, 0x9d, 0x61, 0x6f, 0x03, 0x01, 0xb4, 0x8e, 0x9d, 0x81, 0x33, 0x74,
+	0x7a, 0x86, 0x64, 0x9e, 0x38, 0xc3, 0x9e, 0x89, 0x1f, 0x82, 0xa5, 0xf6, 0x90, 0xc9, 0xf8, 0x08,
+	0x1a, 0x11, 0xe3, 0x7d, 0x63, 0xaf, 0xb1, 0xbf, 0xf5, 0xe0, 0xdd, 0x4a, 0x47, 0x88, 0x94, 0xc0,
+	0x90, 0x2a, 0x71, 0x86, 0x2f, 0xe0, 0xd6, 0x17, 0xd1, 0x94, 0x79, 0x31, 0x3d, 0x0c, 0x7d, 0xf7,
+	0x1b, 0x8f, 0x55, 0x1f, 0xaf, 0x0d, 0x16, 0x5d, 0x30, 0x3a, 0x12, 0xd4, 0x4f, 0x93, 0x99, 0xd2,
+	0x6b, 0xfc, 0xbf, 0xbf, 0x62, 0xf8, 0x75, 0x87, 0x8e, 0x7f, 0x04, 0x5d, 0x97, 0x8a, 0xd3, 0xf1,
+	0xe1, 0x73, 0x4e, 0xc3, 0x37, 0xb9, 0x2c, 0xf8, 0xa0, 0xa8, 0xf9, 0xda, 0x9d, 0x3e, 0x87, 0x9e,
+	0xae, 0xc4, 0xd3, 0xf1, 0xb9, 0x34, 0xf0, 0xc6, 0x41, 0xe3, 0x4f, 0xca, 0x16, 0x5e, 0xbb, 0xe7,
+	0xf7, 0x61, 0x67, 0x40, 0x3d, 0x4e, 0x4f, 0x62, 0x2f, 0x0b, 0x4e, 0xde, 0x5e, 0x23, 0xbb, 0xbd,
+	0xf8, 0x7e, 0x41, 0x84, 0x33, 0xd4, 0x05, 0x33, 0xf0, 0x13, 0x09, 0x33, 0xf0, 0x53, 0x15, 0x73,
+	0xa9, 0xf2, 0x01, 0xdc, 0x52, 0x2a, 0x8f, 0x28, 0x65, 0x87, 0x93, 0x60, 0xae, 0x42, 0x29, 0xa9,
+	0xe1, 0x1f, 0xac, 0x08, 0xdd, 0xc8, 0xf6, 0x1e, 0x74, 0x95, 0x1a, 0xa1, 0xf3, 0xe8, 0xaa, 0xd2,
+	0x70, 0xaf, 0x28, 0xc1, 0x19, 0xfe, 0xa7, 0x01, 0xd6, 0x23, 0x7a, 0xad, 0x72, 0x72, 0xe3, 0x5e,
+	0xb7, 0xda, 0x97, 0x1b, 0x37, 0xe9, 0xcb, 0xcd, 0xda, 0xbe, 0xbc, 0xb9, 0xa6, 0x2f, 0xb7, 0xf2,
+	0x9d, 0xf1, 0xef, 0x26, 0x58, 0xc4, 0x0b, 0x2f, 0x55, 0x74, 0xb7, 0x61, 0x93, 0x0b, 0x2f, 0x16,
+	0x29, 0x68, 0x28, 0x42, 0x06, 0x41, 0xc3, 0xb4, 0x00, 0xe4, 0xa7, 0xec, 0x1e, 0x2c, 0xa6, 0xe3,
+	0x60, 0x91, 0x76, 0x0f, 0x4d, 0xa9, 0x2d, 0x82, 0x69, 0x20, 0x92, 0x86, 0xac, 0x09, 0x59, 0x45,
+	0x57, 0xf4, 0x9a, 0x9f, 0x85, 0x93, 0x6b, 0xe5, 0x93, 0x45, 0x32, 0x5a, 0x56, 0xcc, 0x28, 0x9a,
+	0x85, 0x42, 0x2d, 0xb6, 0x74, 0xc5, 0x64, 0x0c, 0xd9, 0x53, 0x47, 0x51, 0x28, 0x82, 0x70, 0xe6,
+	0x09, 0x19, 0x51, 0x5b, 0xed, 0x56, 0xe0, 0x2d, 0x71, 0xd5, 0xaa, 0xc5, 0xd5, 0xce, 0xcd, 0x70,
+	0x15, 0x2a, 0x71, 0xf5, 0x8f, 0x46, 0x9a, 0x24, 0xce, 0xd0, 0x3e, 0x34, 0xae, 0xe6, 0x69, 0x97,
+	0xb9, 0x53, 0xec, 0x32, 0xe9, 0xc1, 0x13, 0x29, 0x22, 0x5d, 0x53, 0xb1, 0x24, 0x25, 0xa5, 0x09,
+	0x84, 0xa0, 0x39, 0x8d, 0x62, 0xdd, 0x2c, 0x2c, 0xa2, 0xbe, 0x57, 0x02, 0x6d, 0xae, 0x06, 0x8a,
+	0xff, 0x65, 0x42, 0x3b, 0x69, 0x28, 0x15, 0x75, 0xf5, 0x29, 0xb4, 0x84, 0x17, 0x5f, 0x52, 0xbd,
+	0x59, 0xf7, 0xc1, 0xdd, 0xa2, 0x63, 0x89, 0xe2, 0xc1, 0x50, 0xc9, 0x90, 0x44, 0x56, 0x6a, 0xc5,
+	0x94, 0xcf, 0x26, 0xa2, 0xdf, 0xa8, 0xd3, 0x22, 0x4a, 0x86, 0x24, 0xb2, 0xcb, 0x1a, 0x6e, 0xe6,
+	0x6b, 0x58, 0x42, 0xca, 0x6c, 0xfa, 0x9c, 0xc6, 0x49, 0xe1, 0x25, 0x14, 0x76, 0xa1, 0xa5, 0x77,
+	0x45, 0x1d, 0xd8, 0x3c, 0x3f, 0x1c, 0x3c, 0x75, 0x7a, 0x1b, 0x68, 0x0b, 0xda, 0xe7, 0x0e, 0x71,
+	0x4f, 0xcf, 0x9e, 0xf4, 0x0c, 0xf4, 0x1d, 0x78, 0xe7, 0x0b, 0xe2, 0x1c, 0x0e, 0x9d, 0x67, 0xc4,
+	0x39, 0x3f, 0x55, 0x4c, 0x13, 0xf5, 0x60, 0xfb, 0xf1, 0xd9, 0xf1, 0x92, 0xd3, 0x90, 0xfd, 0xdf,
+	0xf9, 0xe5, 0xa9, 0x3b, 0x74, 0x7b, 0x4d, 0xfc, 0x19, 0xb4, 0xb4, 0x53, 0xd2, 0xa8, 0xf3, 0xd5,
+	0xd3, 0xc3, 0x41, 0x6f, 0x03, 0xed, 0x40, 0xe7, 0xc9, 0xd9, 0xf0, 0x99, 0x26, 0x0d, 0xb9, 0xc7,
+	0x89, 0x32, 0x4b, 0x7a, 0x26, 0xb2, 0xa0, 0x39, 0x70, 0x5c, 0xb7, 0xd7, 0xc0, 0x7f, 0x33, 0xa0,
+	0x35, 0x5c, 0x28, 0xf4, 0xbc, 0x0f, 0xd6, 0x48, 0x87, 0xb8, 0x06, 0x35, 0x92, 0x04, 0x90, 0x4c,
+	0x0c, 0x7d, 0x0c, 0x6d, 0xd5, 0xd1, 0x38, 0xef, 0x9b, 0x75, 0x38, 0x93, 0x4a, 0x49, 0x85, 0xb1,
+	0x17, 0x4c, 0x66, 0xea, 0xc4, 0xeb, 0x14, 0x12, 0x29, 0x7c, 0x06, 0x5b, 0xc3, 0x45, 0x78, 0xc6,
+	0x92, 0xf8, 0x3e, 0x04, 0xf3, 0x6a, 0xae, 0x4e, 0x7a, 0x7d, 0xb5, 0x99, 0x57, 0x73, 0x79, 0xf1,
+	0x7d, 0xd5, 0x8f, 0xf5, 0x4d, 0xb5, 0x48, 0x4a, 0xe2, 0x6f, 0x74, 0xbc, 0x6f, 0xf3, 0xe4, 0x40,
+	0x0f, 0xa1, 0xad, 0x0f, 0x9f, 0x27, 0x51, 0x7c, 0xb7, 0xe8, 0x4a, 0xce, 0x63, 0x92, 0x4a, 0xe2,
+	0x2e, 0x6c, 0x13, 0xea, 0xf9, 0xa7, 0xa1, 0x4f, 0x17, 0x84, 0x7e, 0x8d, 0xef, 0xe5, 0x69, 0xfd,
+	0x46, 0x0d, 0xe4, 0xb7, 0x72, 0xa5, 0x49, 0x34, 0x81, 0x7f, 0x05, 0xd6, 0x45, 0x8a, 0xe8, 0xab,
+	0x75, 0xbe, 0x6c, 0x3d, 0x3a, 0xca, 0x84, 0x92, 0x17, 0x5e, 0x75, 0xab, 0x52, 0x03, 0x2d, 0x32,
+	0xf1, 0x3f, 0x8c, 0xd4, 0x38, 0x67, 0xe8, 0xd3, 0xc2, 0xc3, 0x65, 0xaf, 0x18, 0x50, 0x2a, 0x75,
+	0xe0, 0xcc, 0x69, 0x28, 0xde, 0xfc, 0xf9, 0x52, 0xc8, 0x66, 0xb3, 0xf4, 0x80, 0xdb, 0x83, 0x4e,
+	0x66, 0x56, 0x3e, 0x65, 0xbe, 0x7c, 0x5a, 0x7a, 0xdf, 0xe0, 0x1d, 0xd8, 0x72, 0x43, 0x8f, 0xf1,
+	0x17, 0x91, 0x44, 0x4a, 0xfc, 0xeb, 0x1c, 0x59, 0x40, 0xae, 0x8e, 0x42, 0xae, 0x2c, 0x91, 0x66,
+	0x2e, 0x91, 0xb2, 0xd1, 0x08, 0x1a, 0x4f, 0x93, 0x4c, 0xa8, 0x6f, 0xc9, 0xe3, 0xc1, 0x6f, 0x69,
+	0xd2, 0xa0, 0xd5, 0x37, 0xde, 0x82, 0xce, 0x91, 0x37, 0xba, 0x9a, 0xc9, 0x97, 0x0f, 0x7e, 0x3f,
+	0x23, 0x38, 0x93, 0xd2, 0xbe, 0x27, 0x3c, 0xb5, 0xd3, 0x36, 0x51, 0xdf, 0xf8, 0x63, 0x78, 0x77,
+	0x18, 0x7b, 0x21, 0x1f, 0xd3, 0x78, 0x40, 0x3d, 0x9f, 0xc6, 0xfc, 0x45, 0x20, 0x35, 0x73, 0x4f,
+	0x4a, 0x23, 0xff, 0xa4, 0xc4, 0xef, 0x55, 0x2a, 0x70, 0x86, 0x3f, 0x82, 0x1d, 0x42, 0xa7, 0xd1,
+	0x9c, 0x3e, 0x89, 0x7c, 0x5a, 0x67, 0xe1, 0x9d, 0x82, 0x20, 0x67, 0x78, 0x1f, 0xba, 0xc7, 0x74,
+	0x1a, 0x09, 0x7a, 0x2e, 0x1f, 0xa6, 0x75, 0xaa, 0xbd, 0xa2, 0x24, 0x67, 0xf8, 0x1e, 0xc0, 0x97,
+	0x71, 0x24, 0x59, 0x75, 0x7a, 0xdb, 0x4b, 0x29, 0xce, 0x64, 0x86, 0x5c, 0xe1, 0x89, 0x19, 0x97,
+	0x19, 0xfa, 0x83, 0x91, 0x51, 0x9c, 0xad, 0x33, 0x90, 0x40, 0xa9, 0xc8, 0x70, 0x5e, 0x11, 0xb2,
+	0xcf, 0x7b, 0x8c, 0x4d, 0x02, 0xaa, 0x2f, 0x41, 0x3a, 0xd3, 0xe4, 0x79, 0x52, 0x66, 0xe2, 0x71,
+	0x31, 0x88, 0x2e, 0xb5, 0x8c, 0x2e, 0x9e, 0x02, 0x4f, 0x3d, 0x3b, 0x02, 0x2e, 0x1e, 0x53, 0xd9,
+	0x61, 0x95, 0x57, 0x7f, 0x32, 0xa0, 0xa5, 0xc9, 0x95, 0xea, 0xa8, 0x9b, 0x03, 0xf2, 0xf3, 0x43,
+	0x63, 0x75, 0x7e, 0xe0, 0xb3, 0xf1, 0x38, 0xf6, 0x2e, 0xd3, 0x4e, 0x9f, 0xd1, 0x32, 0xec, 0x89,
+	0x3a, 0xcc, 0x04, 0xd1, 0x13, 0x0a, 0x7f, 0x5e, 0x74, 0x8c, 0xcb, 0xf1, 0xa0, 0x3d, 0xd5, 0x54,
+	0xd2, 0x62, 0x6f, 0x17, 0x2f, 0x9a, 0x16, 0x25, 0xa9, 0xd0, 0x83, 0x7f, 0x6f, 0x03, 0x10, 0x36,
+	0x72, 0xb5, 0x00, 0x7a, 0x08, 0x8d, 0x13, 0x2a, 0x50, 0x49, 0x49, 0x4f, 0xd9, 0x76, 0x05, 0x97,
+	0x33, 0xbc, 0x21, 0x95, 0xdc, 0x55, 0x25, 0xb7, 0x52, 0xc9, 0x4d, 0x95, 0x7e, 0x02, 0x2d, 0xfd,
+	0xa0, 0x45, 0xef, 0x15, 0x25, 0xb2, 0xa9, 0xcf, 0xae, 0x5e, 0x50, 0xda, 0x3f, 0x84, 0xa6, 0x1c,
+	0x8e, 0x50, 0xa9, 0xbb, 0x27, 0x73, 0x9a, 0x5d, 0xc5, 0x56, 0x7a, 0x9f, 0xc1, 0xa6, 0x42, 0x00,
+	0x74, 0xa7, 0x02, 0x16, 0xa4, 0x66, 0x25, 0x5f, 0xa9, 0x0e, 0xa1, 0x5b, 0x1c, 0x30, 0xd0, 0xfb,
+	0x95, 0xe8, 0xb5, 0x9c, 0x6b, 0xec, 0x7a, 0x01, 0x65, 0xf5, 0x11, 0x6c, 0xe5, 0x26, 0x09, 0x74,
+	0x77, 0x25, 0x5b, 0xb9, 0xf1, 0xc4, 0xae, 0x59, 0x55, 0xc6, 0xbe, 0x82, 0x9d, 0xc2, 0x90, 0x80,
+	0x76, 0xab, 0x32, 0xb8, 0x9c, 0x41, 0xec, 0xda, 0x75, 0x65, 0xf2, 0xe7, 0x00, 0xcb, 0x11, 0x01,
+	0x7d, 0xaf, 0x28, 0x5f, 0x98, 0x2f, 0xec, 0xf5, 0x8b, 0x69, 0xfe, 0x8a, 0x43, 0x41, 0x39, 0x7f,
+	0x2b, 0x73, 0x85, 0x5d, 0x2f, 0x90, 0xe6, 0x2f, 0x37, 0x11, 0x94, 0xf3, 0x57, 0x1c, 0x27, 0xec,
+	0x9a, 0x55, 0x65, 0xec, 0xc7, 0xb0, 0x79, 0x51, 0x55, 0x1d, 0x17, 0x6b, 0xaa, 0xe3, 0x22, 0xab,
+	0x8e, 0x4f, 0x0c, 0x59, 0x5a, 0xea, 0xd1, 0x5a, 0x56, 0x4e, 0x9f, 0xfb, 0x76, 0x25, 0x3f, 0xbd,
+	0x40, 0xc3, 0x45, 0x58, 0xbe, 0x40, 0xfa, 0xd5, 0x64, 0x57, 0x70, 0x95, 0x92, 0x03, 0x9d, 0x0c,
+	0xde, 0x51, 0xe9, 0x3f, 0x40, 0xfe, 0x1d, 0x60, 0xaf, 0x5d, 0x53, 0x66, 0x7e, 0x0a, 0x2d, 0x8d,
+	0x40, 0xe5, 0x7b, 0x98, 0x81, 0x94, 0x5d, 0xbd, 0x90, 0x84, 0x7d, 0x04, 0x56, 0x8a, 0x95, 0xa8,
+	0xf4, 0x4a, 0xc9, 0x41, 0xaa, 0xbd, 0x6e, 0x49, 0xf9, 0xf0, 0x1b, 0x40, 0xab, 0x98, 0x85, 0x3e,
+	0x28, 0x05, 0x5e, 0x05, 0x83, 0xf6, 0xeb, 0x85, 0xd2, 0x32, 0x5e, 0x62, 0x5a, 0xb9, 0x8c, 0x0b,
+	0xb0, 0x68, 0xaf, 0x5f, 0x4c, 0x0b, 0x2e, 0x07, 0x71, 0xe5, 0x82, 0x2b, 0xe2, 0xa4, 0x5d, 0xb3,
+	0x9a, 0x55, 0xef, 0xb2, 0x7f, 0xaf, 0x54, 0x6f, 0x01, 0x73, 0xec, 0x9a, 0x55, 0x65, 0xec, 0x67,
+	0xd0, 0x4e, 0x40, 0x14, 0xf5, 0x8b, 0xa2, 0x4b, 0x04, 0xb6, 0xd7, 0xac, 0xa4, 0x2d, 0x59, 0x23,
+	0x6d, 0xb9, 0x14, 0x32, 0x34, 0xb6, 0xab, 0x17, 0xa4, 0xf6, 0x51, 0xff, 0x3f, 0x2f, 0x77, 0x8d,
+	0x6f, 0x5f, 0xee, 0x1a, 0xff, 0x7b, 0xb9, 0x6b, 0xfc, 0xe5, 0xd5, 0xee, 0xc6, 0xb7, 0xaf, 0x76,
+	0x37, 0xfe, 0xfb, 0x6a, 0x77, 0xe3, 0x79, 0x4b, 0xfd, 0xbc, 0x7d, 0xf8, 0xff, 0x01, 0x00, 0x5e,
+	0x4f, 0x96, 0xa8, 0xd1, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RpcServiceClient is the client API for RpcService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RpcServiceClient interface {
+	Get(ctx context.Context, in *GetReq, opts ...grpc.CallOption) (*GetRsp, error)
+	Set(ctx context.Context, in *SetReq, opts ...grpc.CallOption) (*SetRsp, error)
+	Delete(ctx context.Context, in *DeleteReq, opts ...grpc.CallOption) (*DeleteRsp, error)
+	Join(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (*JoinRsp, error)
+	Batch(ctx context.Context, in *BatchReq, opts ...grpc.CallOption) (*BatchRsp, error)
+	CompareAndSwap(ctx context.Context, in *CompareAndSwapReq, opts ...grpc.CallOption) (*CompareAndSwapRsp, error)
+	SetIfAbsent(ctx context.Context, in *SetIfAbsentReq, opts ...grpc.CallOption) (*SetIfAbsentRsp, error)
+	DeleteIfValue(ctx context.Context, in *DeleteIfValueReq, opts ...grpc.CallOption) (*DeleteIfValueRsp, error)
+	LeaseGrant(ctx context.Context, in *LeaseGrantReq, opts ...grpc.CallOption) (*LeaseGrantRsp, error)
+	LeaseKeepAlive(ctx context.Context, in *LeaseKeepAliveReq, opts ...grpc.CallOption) (*LeaseKeepAliveRsp, error)
+	LeaseRevoke(ctx context.Context, in *LeaseRevokeReq, opts ...grpc.CallOption) (*LeaseRevokeRsp, error)
+	Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (RpcService_WatchClient, error)
+	Range(ctx context.Context, in *RangeReq, opts ...grpc.CallOption) (*RangeRsp, error)
+	Txn(ctx context.Context, in *TxnReq, opts ...grpc.CallOption) (*TxnRsp, error)
+	ReadIndex(ctx context.Context, in *ReadIndexReq, opts ...grpc.CallOption) (*ReadIndexRsp, error)
+	Backup(ctx context.Context, in *BackupReq, opts ...grpc.CallOption) (RpcService_BackupClient, error)
+	Snapshot(ctx context.Context, in *SnapshotReq, opts ...grpc.CallOption) (*SnapshotRsp, error)
+	TransferLeadership(ctx context.Context, in *TransferLeadershipReq, opts ...grpc.CallOption) (*TransferLeadershipRsp, error)
+	RemoveNode(ctx context.Context, in *RemoveNodeReq, opts ...grpc.CallOption) (*RemoveNodeRsp, error)
+	DemoteVoter(ctx context.Context, in *DemoteVoterReq, opts ...grpc.CallOption) (*DemoteVoterRsp, error)
+	ListMembers(ctx context.Context, in *ListMembersReq, opts ...grpc.CallOption) (*ListMembersRsp, error)
+	Promote(ctx context.Context, in *PromoteReq, opts ...grpc.CallOption) (*PromoteRsp, error)
+	Status(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusRsp, error)
+}
+
+type rpcServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRpcServiceClient(cc *grpc.ClientConn) RpcServiceClient {
+	return &rpcServiceClient{cc}
+}
+
+func (c *rpcServiceClient) Get(ctx context.Context, in *GetReq, opts ...grpc.CallOption) (*GetRsp, error) {
+	out := new(GetRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) Set(ctx context.Context, in *SetReq, opts ...grpc.CallOption) (*SetRsp, error) {
+	out := new(SetRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) Delete(ctx context.Context, in *DeleteReq, opts ...grpc.CallOption) (*DeleteRsp, error) {
+	out := new(DeleteRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) Join(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (*JoinRsp, error) {
+	out := new(JoinRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Join", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) Batch(ctx context.Context, in *BatchReq, opts ...grpc.CallOption) (*BatchRsp, error) {
+	out := new(BatchRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) CompareAndSwap(ctx context.Context, in *CompareAndSwapReq, opts ...grpc.CallOption) (*CompareAndSwapRsp, error) {
+	out := new(CompareAndSwapRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/CompareAndSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) SetIfAbsent(ctx context.Context, in *SetIfAbsentReq, opts ...grpc.CallOption) (*SetIfAbsentRsp, error) {
+	out := new(SetIfAbsentRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/SetIfAbsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) DeleteIfValue(ctx context.Context, in *DeleteIfValueReq, opts ...grpc.CallOption) (*DeleteIfValueRsp, error) {
+	out := new(DeleteIfValueRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/DeleteIfValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) LeaseGrant(ctx context.Context, in *LeaseGrantReq, opts ...grpc.CallOption) (*LeaseGrantRsp, error) {
+	out := new(LeaseGrantRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/LeaseGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) LeaseKeepAlive(ctx context.Context, in *LeaseKeepAliveReq, opts ...grpc.CallOption) (*LeaseKeepAliveRsp, error) {
+	out := new(LeaseKeepAliveRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/LeaseKeepAlive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) LeaseRevoke(ctx context.Context, in *LeaseRevokeReq, opts ...grpc.CallOption) (*LeaseRevokeRsp, error) {
+	out := new(LeaseRevokeRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/LeaseRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (RpcService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RpcService_serviceDesc.Streams[0], "/rpcservicepb.RpcService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rpcServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RpcService_WatchClient interface {
+	Recv() (*WatchRsp, error)
+	grpc.ClientStream
+}
+
+type rpcServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *rpcServiceWatchClient) Recv() (*WatchRsp, error) {
+	m := new(WatchRsp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rpcServiceClient) Range(ctx context.Context, in *RangeReq, opts ...grpc.CallOption) (*RangeRsp, error) {
+	out := new(RangeRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Range", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) Txn(ctx context.Context, in *TxnReq, opts ...grpc.CallOption) (*TxnRsp, error) {
+	out := new(TxnRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Txn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) ReadIndex(ctx context.Context, in *ReadIndexReq, opts ...grpc.CallOption) (*ReadIndexRsp, error) {
+	out := new(ReadIndexRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/ReadIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) Backup(ctx context.Context, in *BackupReq, opts ...grpc.CallOption) (RpcService_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RpcService_serviceDesc.Streams[1], "/rpcservicepb.RpcService/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rpcServiceBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RpcService_BackupClient interface {
+	Recv() (*BackupRsp, error)
+	grpc.ClientStream
+}
+
+type rpcServiceBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *rpcServiceBackupClient) Recv() (*BackupRsp, error) {
+	m := new(BackupRsp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rpcServiceClient) Snapshot(ctx context.Context, in *SnapshotReq, opts ...grpc.CallOption) (*SnapshotRsp, error) {
+	out := new(SnapshotRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Snapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) TransferLeadership(ctx context.Context, in *TransferLeadershipReq, opts ...grpc.CallOption) (*TransferLeadershipRsp, error) {
+	out := new(TransferLeadershipRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/TransferLeadership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RemoveNode(ctx context.Context, in *RemoveNodeReq, opts ...grpc.CallOption) (*RemoveNodeRsp, error) {
+	out := new(RemoveNodeRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/RemoveNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) DemoteVoter(ctx context.Context, in *DemoteVoterReq, opts ...grpc.CallOption) (*DemoteVoterRsp, error) {
+	out := new(DemoteVoterRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/DemoteVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) ListMembers(ctx context.Context, in *ListMembersReq, opts ...grpc.CallOption) (*ListMembersRsp, error) {
+	out := new(ListMembersRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/ListMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) Promote(ctx context.Context, in *PromoteReq, opts ...grpc.CallOption) (*PromoteRsp, error) {
+	out := new(PromoteRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Promote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) Status(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusRsp, error) {
+	out := new(StatusRsp)
+	err := c.cc.Invoke(ctx, "/rpcservicepb.RpcService/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RpcServiceServer is the server API for RpcService service.
+type RpcServiceServer interface {
+	Get(context.Context, *GetReq) (*GetRsp, error)
+	Set(context.Context, *SetReq) (*SetRsp, error)
+	Delete(context.Context, *DeleteReq) (*DeleteRsp, error)
+	Join(context.Context, *JoinReq) (*JoinRsp, error)
+	Batch(context.Context, *BatchReq) (*BatchRsp, error)
+	CompareAndSwap(context.Context, *CompareAndSwapReq) (*CompareAndSwapRsp, error)
+	SetIfAbsent(context.Context, *SetIfAbsentReq) (*SetIfAbsentRsp, error)
+	DeleteIfValue(context.Context, *DeleteIfValueReq) (*DeleteIfValueRsp, error)
+	LeaseGrant(context.Context, *LeaseGrantReq) (*LeaseGrantRsp, error)
+	LeaseKeepAlive(context.Context, *LeaseKeepAliveReq) (*LeaseKeepAliveRsp, error)
+	LeaseRevoke(context.Context, *LeaseRevokeReq) (*LeaseRevokeRsp, error)
+	Watch(*WatchReq, RpcService_WatchServer) error
+	Range(context.Context, *RangeReq) (*RangeRsp, error)
+	Txn(context.Context, *TxnReq) (*TxnRsp, error)
+	ReadIndex(context.Context, *ReadIndexReq) (*ReadIndexRsp, error)
+	Backup(*BackupReq, RpcService_BackupServer) error
+	Snapshot(context.Context, *SnapshotReq) (*SnapshotRsp, error)
+	TransferLeadership(context.Context, *TransferLeadershipReq) (*TransferLeadershipRsp, error)
+	RemoveNode(context.Context, *RemoveNodeReq) (*RemoveNodeRsp, error)
+	DemoteVoter(context.Context, *DemoteVoterReq) (*DemoteVoterRsp, error)
+	ListMembers(context.Context, *ListMembersReq) (*ListMembersRsp, error)
+	Promote(context.Context, *PromoteReq) (*PromoteRsp, error)
+	Status(context.Context, *StatusReq) (*StatusRsp, error)
+}
+
+// UnimplementedRpcServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRpcServiceServer struct {
+}
+
+func (*UnimplementedRpcServiceServer) Get(ctx context.Context, req *GetReq) (*GetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedRpcServiceServer) Set(ctx context.Context, req *SetReq) (*SetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedRpcServiceServer) Delete(ctx context.Context, req *DeleteReq) (*DeleteRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedRpcServiceServer) Join(ctx context.Context, req *JoinReq) (*JoinRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Join not implemented")
+}
+func (*UnimplementedRpcServiceServer) Batch(ctx context.Context, req *BatchReq) (*BatchRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (*UnimplementedRpcServiceServer) CompareAndSwap(ctx context.Context, req *CompareAndSwapReq) (*CompareAndSwapRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareAndSwap not implemented")
+}
+func (*UnimplementedRpcServiceServer) SetIfAbsent(ctx context.Context, req *SetIfAbsentReq) (*SetIfAbsentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIfAbsent not implemented")
+}
+func (*UnimplementedRpcServiceServer) DeleteIfValue(ctx context.Context, req *DeleteIfValueReq) (*DeleteIfValueRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIfValue not implemented")
+}
+func (*UnimplementedRpcServiceServer) LeaseGrant(ctx context.Context, req *LeaseGrantReq) (*LeaseGrantRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseGrant not implemented")
+}
+func (*UnimplementedRpcServiceServer) LeaseKeepAlive(ctx context.Context, req *LeaseKeepAliveReq) (*LeaseKeepAliveRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseKeepAlive not implemented")
+}
+func (*UnimplementedRpcServiceServer) LeaseRevoke(ctx context.Context, req *LeaseRevokeReq) (*LeaseRevokeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseRevoke not implemented")
+}
+func (*UnimplementedRpcServiceServer) Watch(req *WatchReq, srv RpcService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedRpcServiceServer) Range(ctx context.Context, req *RangeReq) (*RangeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Range not implemented")
+}
+func (*UnimplementedRpcServiceServer) Txn(ctx context.Context, req *TxnReq) (*TxnRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Txn not implemented")
+}
+func (*UnimplementedRpcServiceServer) ReadIndex(ctx context.Context, req *ReadIndexReq) (*ReadIndexRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadIndex not implemented")
+}
+func (*UnimplementedRpcServiceServer) Backup(req *BackupReq, srv RpcService_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (*UnimplementedRpcServiceServer) Snapshot(ctx context.Context, req *SnapshotReq) (*SnapshotRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (*UnimplementedRpcServiceServer) TransferLeadership(ctx context.Context, req *TransferLeadershipReq) (*TransferLeadershipRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLeadership not implemented")
+}
+func (*UnimplementedRpcServiceServer) RemoveNode(ctx context.Context, req *RemoveNodeReq) (*RemoveNodeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNode not implemented")
+}
+func (*UnimplementedRpcServiceServer) DemoteVoter(ctx context.Context, req *DemoteVoterReq) (*DemoteVoterRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemoteVoter not implemented")
+}
+func (*UnimplementedRpcServiceServer) ListMembers(ctx context.Context, req *ListMembersReq) (*ListMembersRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (*UnimplementedRpcServiceServer) Promote(ctx context.Context, req *PromoteReq) (*PromoteRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Promote not implemented")
+}
+func (*UnimplementedRpcServiceServer) Status(ctx context.Context, req *StatusReq) (*StatusRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+
+func RegisterRpcServiceServer(s *grpc.Server, srv RpcServiceServer) {
+	s.RegisterService(&_RpcService_serviceDesc, srv)
+}
+
+func _RpcService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).Get(ctx, req.(*GetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).Set(ctx, req.(*SetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).Delete(ctx, req.(*DeleteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).Join(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/Join",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).Join(ctx, req.(*JoinReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).Batch(ctx, req.(*BatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_CompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSwapReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).CompareAndSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/CompareAndSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).CompareAndSwap(ctx, req.(*CompareAndSwapReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_SetIfAbsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIfAbsentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).SetIfAbsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/SetIfAbsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).SetIfAbsent(ctx, req.(*SetIfAbsentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_DeleteIfValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIfValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).DeleteIfValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/DeleteIfValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).DeleteIfValue(ctx, req.(*DeleteIfValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_LeaseGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseGrantReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).LeaseGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/LeaseGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).LeaseGrant(ctx, req.(*LeaseGrantReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_LeaseKeepAlive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseKeepAliveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).LeaseKeepAlive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/LeaseKeepAlive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).LeaseKeepAlive(ctx, req.(*LeaseKeepAliveReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_LeaseRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseRevokeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).LeaseRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/LeaseRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).LeaseRevoke(ctx, req.(*LeaseRevokeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RpcServiceServer).Watch(m, &rpcServiceWatchServer{stream})
+}
+
+type RpcService_WatchServer interface {
+	Send(*WatchRsp) error
+	grpc.ServerStream
+}
+
+type rpcServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *rpcServiceWatchServer) Send(m *WatchRsp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RpcService_Range_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).Range(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/Range",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).Range(ctx, req.(*RangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_Txn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).Txn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/Txn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).Txn(ctx, req.(*TxnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_ReadIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadIndexReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).ReadIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/ReadIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).ReadIndex(ctx, req.(*ReadIndexReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RpcServiceServer).Backup(m, &rpcServiceBackupServer{stream})
+}
+
+type RpcService_BackupServer interface {
+	Send(*BackupRsp) error
+	grpc.ServerStream
+}
+
+type rpcServiceBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *rpcServiceBackupServer) Send(m *BackupRsp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RpcService_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/Snapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).Snapshot(ctx, req.(*SnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_TransferLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferLeadershipReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).TransferLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/TransferLeadership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).TransferLeadership(ctx, req.(*TransferLeadershipReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveNodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RemoveNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/RemoveNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RemoveNode(ctx, req.(*RemoveNodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_DemoteVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DemoteVoterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).DemoteVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/DemoteVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).DemoteVoter(ctx, req.(*DemoteVoterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/ListMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).ListMembers(ctx, req.(*ListMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/Promote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).Promote(ctx, req.(*PromoteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcservicepb.RpcService/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).Status(ctx, req.(*StatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RpcService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcservicepb.RpcService",
+	HandlerType: (*RpcServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _RpcService_Get_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _RpcService_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _RpcService_Delete_Handler,
+		},
+		{
+			MethodName: "Join",
+			Handler:    _RpcService_Join_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _RpcService_Batch_Handler,
+		},
+		{
+			MethodName: "CompareAndSwap",
+			Handler:    _RpcService_CompareAndSwap_Handler,
+		},
+		{
+			MethodName: "SetIfAbsent",
+			Handler:    _RpcService_SetIfAbsent_Handler,
+		},
+		{
+			MethodName: "DeleteIfValue",
+			Handler:    _RpcService_DeleteIfValue_Handler,
+		},
+		{
+			MethodName: "LeaseGrant",
+			Handler:    _RpcService_LeaseGrant_Handler,
+		},
+		{
+			MethodName: "LeaseKeepAlive",
+			Handler:    _RpcService_LeaseKeepAlive_Handler,
+		},
+		{
+			MethodName: "LeaseRevoke",
+			Handler:    _RpcService_LeaseRevoke_Handler,
+		},
+		{
+			MethodName: "Range",
+			Handler:    _RpcService_Range_Handler,
+		},
+		{
+			MethodName: "Txn",
+			Handler:    _RpcService_Txn_Handler,
+		},
+		{
+			MethodName: "ReadIndex",
+			Handler:    _RpcService_ReadIndex_Handler,
+		},
+		{
+			MethodName: "Snapshot",
+			Handler:    _RpcService_Snapshot_Handler,
+		},
+		{
+			MethodName: "TransferLeadership",
+			Handler:    _RpcService_TransferLeadership_Handler,
+		},
+		{
+			MethodName: "RemoveNode",
+			Handler:    _RpcService_RemoveNode_Handler,
+		},
+		{
+			MethodName: "DemoteVoter",
+			Handler:    _RpcService_DemoteVoter_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _RpcService_ListMembers_Handler,
+		},
+		{
+			MethodName: "Promote",
+			Handler:    _RpcService_Promote_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _RpcService_Status_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _RpcService_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Backup",
+			Handler:       _RpcService_Backup_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc_service.proto",
+}
+
+func (m *GetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxStalenessMs != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.MaxStalenessMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxLagEntries != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.MaxLagEntries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lease != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Lease))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Version != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ModRevision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.ModRevision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreateRevision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.CreateRevision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lease != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Lease))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Ttl != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PrevRevision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.PrevRevision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrevRevision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.PrevRevision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonvoter {
+		i--
+		if m.Nonvoter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RaftAddr) > 0 {
+		i -= len(m.RaftAddr)
+		copy(dAtA[i:], m.RaftAddr)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.RaftAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GrpcAddr) > 0 {
+		i -= len(m.GrpcAddr)
+		copy(dAtA[i:], m.GrpcAddr)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.GrpcAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CompareAndSwapReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompareAndSwapReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompareAndSwapReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Expected) > 0 {
+		i -= len(m.Expected)
+		copy(dAtA[i:], m.Expected)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Expected)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompareAndSwapRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompareAndSwapRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompareAndSwapRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetIfAbsentReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetIfAbsentReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetIfAbsentReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetIfAbsentRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetIfAbsentRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetIfAbsentRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteIfValueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteIfValueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteIfValueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expected) > 0 {
+		i -= len(m.Expected)
+		copy(dAtA[i:], m.Expected)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Expected)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteIfValueRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteIfValueRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteIfValueRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseGrantReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseGrantReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseGrantReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ttl != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseGrantRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseGrantRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseGrantRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ttl != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseKeepAliveReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseKeepAliveReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseKeepAliveReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseKeepAliveRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseKeepAliveRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseKeepAliveRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ttl != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseRevokeReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseRevokeReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseRevokeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaseRevokeRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseRevokeRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseRevokeRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lease != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Lease))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Version != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ModRevision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.ModRevision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreateRevision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.CreateRevision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxStalenessMs != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.MaxStalenessMs))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxLagEntries != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.MaxLagEntries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Continuation) > 0 {
+		i -= len(m.Continuation)
+		copy(dAtA[i:], m.Continuation)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Continuation)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CountOnly {
+		i--
+		if m.CountOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.KeysOnly {
+		i--
+		if m.KeysOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Limit != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Continuation) > 0 {
+		i -= len(m.Continuation)
+		copy(dAtA[i:], m.Continuation)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Continuation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.More {
+		i--
+		if m.More {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Kvs) > 0 {
+		for iNdEx := len(m.Kvs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kvs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Compare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Compare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Compare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Result != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Target != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failure) > 0 {
+		for iNdEx := len(m.Failure) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failure[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Success) > 0 {
+		for iNdEx := len(m.Success) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Success[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Compares) > 0 {
+		for iNdEx := len(m.Compares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Compares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnOpResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnOpResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnOpResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kv != nil {
+		{
+			size, err := m.Kv.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Revision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadIndexReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadIndexReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadIndexReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadIndexRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadIndexRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadIndexRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartRevision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.StartRevision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Prefix {
+		i--
+		if m.Prefix {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Term != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Term))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLeadershipReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeadershipReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeadershipReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLeadershipRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeadershipRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeadershipRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveNodeReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveNodeReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveNodeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveNodeRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveNodeRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveNodeRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DemoteVoterReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DemoteVoterReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DemoteVoterReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DemoteVoterRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DemoteVoterRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DemoteVoterRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PromoteReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromoteReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoteReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PromoteRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromoteRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoteRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastLogIndex != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.LastLogIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AppliedIndex != 0 {
+		i = encodeVarintRpcService(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMembersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMembersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMembersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Member) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Leader {
+		i--
+		if m.Leader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Suffrage) > 0 {
+		i -= len(m.Suffrage)
+		copy(dAtA[i:], m.Suffrage)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Suffrage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GrpcAddr) > 0 {
+		i -= len(m.GrpcAddr)
+		copy(dAtA[i:], m.GrpcAddr)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.GrpcAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RaftAddr) > 0 {
+		i -= len(m.RaftAddr)
+		copy(dAtA[i:], m.RaftAddr)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.RaftAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintRpcService(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMembersRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMembersRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMembersRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpcService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpcService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.MaxLagEntries != 0 {
+		n += 1 + sovRpcService(uint64(m.MaxLagEntries))
+	}
+	if m.MaxStalenessMs != 0 {
+		n += 1 + sovRpcService(uint64(m.MaxStalenessMs))
+	}
+	return n
+}
+
+func (m *GetRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.CreateRevision != 0 {
+		n += 1 + sovRpcService(uint64(m.CreateRevision))
+	}
+	if m.ModRevision != 0 {
+		n += 1 + sovRpcService(uint64(m.ModRevision))
+	}
+	if m.Version != 0 {
+		n += 1 + sovRpcService(uint64(m.Version))
+	}
+	if m.Lease != 0 {
+		n += 1 + sovRpcService(uint64(m.Lease))
+	}
+	return n
+}
+
+func (m *SetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.PrevRevision != 0 {
+		n += 1 + sovRpcService(uint64(m.PrevRevision))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovRpcService(uint64(m.Ttl))
+	}
+	if m.Lease != 0 {
+		n += 1 + sovRpcService(uint64(m.Lease))
+	}
+	return n
+}
+
+func (m *SetRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Succeeded {
+		n += 2
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRpcService(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *DeleteReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.PrevRevision != 0 {
+		n += 1 + sovRpcService(uint64(m.PrevRevision))
+	}
+	return n
+}
+
+func (m *DeleteRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Succeeded {
+		n += 2
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRpcService(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *JoinReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GrpcAddr)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.RaftAddr)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.Nonvoter {
+		n += 2
+	}
+	return n
+}
+
+func (m *JoinRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRpcService(uint64(m.Type))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for _, e := range m.Ops {
+			l = e.Size()
+			n += 1 + l + sovRpcService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CompareAndSwapReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Expected)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	return n
+}
+
+func (m *CompareAndSwapRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Succeeded {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetIfAbsentReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	return n
+}
+
+func (m *SetIfAbsentRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Succeeded {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeleteIfValueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Expected)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteIfValueRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Succeeded {
+		n += 2
+	}
+	return n
+}
+
+func (m *LeaseGrantReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ttl != 0 {
+		n += 1 + sovRpcService(uint64(m.Ttl))
+	}
+	return n
+}
+
+func (m *LeaseGrantRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRpcService(uint64(m.Id))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovRpcService(uint64(m.Ttl))
+	}
+	return n
+}
+
+func (m *LeaseKeepAliveReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRpcService(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *LeaseKeepAliveRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRpcService(uint64(m.Id))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovRpcService(uint64(m.Ttl))
+	}
+	return n
+}
+
+func (m *LeaseRevokeReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRpcService(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *LeaseRevokeRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *KeyValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.CreateRevision != 0 {
+		n += 1 + sovRpcService(uint64(m.CreateRevision))
+	}
+	if m.ModRevision != 0 {
+		n += 1 + sovRpcService(uint64(m.ModRevision))
+	}
+	if m.Version != 0 {
+		n += 1 + sovRpcService(uint64(m.Version))
+	}
+	if m.Lease != 0 {
+		n += 1 + sovRpcService(uint64(m.Lease))
+	}
+	return n
+}
+
+func (m *RangeReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRpcService(uint64(m.Limit))
+	}
+	if m.KeysOnly {
+		n += 2
+	}
+	if m.CountOnly {
+		n += 2
+	}
+	l = len(m.Continuation)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.MaxLagEntries != 0 {
+		n += 1 + sovRpcService(uint64(m.MaxLagEntries))
+	}
+	if m.MaxStalenessMs != 0 {
+		n += 1 + sovRpcService(uint64(m.MaxStalenessMs))
+	}
+	return n
+}
+
+func (m *RangeRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Kvs) > 0 {
+		for _, e := range m.Kvs {
+			l = e.Size()
+			n += 1 + l + sovRpcService(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovRpcService(uint64(m.Count))
+	}
+	if m.More {
+		n += 2
+	}
+	l = len(m.Continuation)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	return n
+}
+
+func (m *Compare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.Target != 0 {
+		n += 1 + sovRpcService(uint64(m.Target))
+	}
+	if m.Result != 0 {
+		n += 1 + sovRpcService(uint64(m.Result))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovRpcService(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *TxnReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Compares) > 0 {
+		for _, e := range m.Compares {
+			l = e.Size()
+			n += 1 + l + sovRpcService(uint64(l))
+		}
+	}
+	if len(m.Success) > 0 {
+		for _, e := range m.Success {
+			l = e.Size()
+			n += 1 + l + sovRpcService(uint64(l))
+		}
+	}
+	if len(m.Failure) > 0 {
+		for _, e := range m.Failure {
+			l = e.Size()
+			n += 1 + l + sovRpcService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxnOpResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kv != nil {
+		l = m.Kv.Size()
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
+func (m *TxnRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Succeeded {
+		n += 2
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRpcService(uint64(m.Revision))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovRpcService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReadIndexReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReadIndexRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovRpcService(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *WatchReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.Prefix {
+		n += 2
+	}
+	if m.StartRevision != 0 {
+		n += 1 + sovRpcService(uint64(m.StartRevision))
+	}
+	return n
+}
+
+func (m *WatchRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRpcService(uint64(m.Type))
+	}
 	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovRpcService(uint64(l))
 	}
-	return n
-}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRpcService(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *SnapshotReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SnapshotRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovRpcService(uint64(m.Index))
+	}
+	if m.Term != 0 {
+		n += 1 + sovRpcService(uint64(m.Term))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovRpcService(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *BackupReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BackupRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferLeadershipReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferLeadershipRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveNodeReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveNodeRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DemoteVoterReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	return n
+}
+
+func (m *DemoteVoterRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PromoteReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	return n
+}
+
+func (m *PromoteRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StatusReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StatusRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.AppliedIndex != 0 {
+		n += 1 + sovRpcService(uint64(m.AppliedIndex))
+	}
+	if m.LastLogIndex != 0 {
+		n += 1 + sovRpcService(uint64(m.LastLogIndex))
+	}
+	return n
+}
+
+func (m *ListMembersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Member) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.RaftAddr)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.GrpcAddr)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	l = len(m.Suffrage)
+	if l > 0 {
+		n += 1 + l + sovRpcService(uint64(l))
+	}
+	if m.Leader {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListMembersRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovRpcService(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRpcService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcService(x uint64) (n int) {
+	return sovRpcService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLagEntries", wireType)
+			}
+			m.MaxLagEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLagEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStalenessMs", wireType)
+			}
+			m.MaxStalenessMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStalenessMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRevision", wireType)
+			}
+			m.CreateRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModRevision", wireType)
+			}
+			m.ModRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			m.Lease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lease |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRevision", wireType)
+			}
+			m.PrevRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			m.Lease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lease |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRevision", wireType)
+			}
+			m.PrevRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrpcAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrpcAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RaftAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonvoter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nonvoter = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= BatchOp_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ops = append(m.Ops, &BatchOp{})
+			if err := m.Ops[len(m.Ops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompareAndSwapReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompareAndSwapReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompareAndSwapReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expected = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompareAndSwapRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompareAndSwapRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompareAndSwapRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetIfAbsentReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetIfAbsentReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetIfAbsentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetIfAbsentRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetIfAbsentRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetIfAbsentRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteIfValueReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteIfValueReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteIfValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expected = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteIfValueRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteIfValueRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteIfValueRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseGrantReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseGrantReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseGrantReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseGrantRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseGrantRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseGrantRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseKeepAliveReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseKeepAliveReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseKeepAliveReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseKeepAliveRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseKeepAliveRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseKeepAliveRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseRevokeReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseRevokeReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseRevokeReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseRevokeRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseRevokeRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseRevokeRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRevision", wireType)
+			}
+			m.CreateRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModRevision", wireType)
+			}
+			m.ModRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			m.Lease = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lease |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeysOnly = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountOnly = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continuation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Continuation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLagEntries", wireType)
+			}
+			m.MaxLagEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLagEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStalenessMs", wireType)
+			}
+			m.MaxStalenessMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStalenessMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kvs = append(m.Kvs, &KeyValue{})
+			if err := m.Kvs[len(m.Kvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field More", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.More = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continuation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Continuation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Compare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Compare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Compare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= Compare_Target(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= Compare_Result(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compares = append(m.Compares, &Compare{})
+			if err := m.Compares[len(m.Compares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Success = append(m.Success, &BatchOp{})
+			if err := m.Success[len(m.Success)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failure = append(m.Failure, &BatchOp{})
+			if err := m.Failure[len(m.Failure)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnOpResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnOpResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnOpResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kv == nil {
+				m.Kv = &KeyValue{}
+			}
+			if err := m.Kv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &TxnOpResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadIndexReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadIndexReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadIndexReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadIndexRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadIndexRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadIndexRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prefix = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRevision", wireType)
+			}
+			m.StartRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= WatchRsp_EventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferLeadershipReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLeadershipReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLeadershipReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *DeleteRsp) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *JoinReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GrpcAddr)
-	if l > 0 {
-		n += 1 + l + sovRpcService(uint64(l))
-	}
-	l = len(m.RaftAddr)
-	if l > 0 {
-		n += 1 + l + sovRpcService(uint64(l))
-	}
-	l = len(m.NodeID)
-	if l > 0 {
-		n += 1 + l + sovRpcService(uint64(l))
+func (m *TransferLeadershipRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLeadershipRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLeadershipRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *JoinRsp) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovRpcService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRpcService(x uint64) (n int) {
-	return sovRpcService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetReq) Unmarshal(dAtA []byte) error {
+func (m *RemoveNodeReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1006,15 +11041,15 @@ func (m *GetReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveNodeReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveNodeReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1042,11 +11077,111 @@ func (m *GetReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.NodeID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveNodeRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveNodeRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveNodeRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DemoteVoterReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DemoteVoterReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DemoteVoterReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1071,11 +11206,61 @@ func (m *GetReq) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthRpcService
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DemoteVoterRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcService
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DemoteVoterRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DemoteVoterRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcService(dAtA[iNdEx:])
@@ -1097,7 +11282,7 @@ func (m *GetReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRsp) Unmarshal(dAtA []byte) error {
+func (m *PromoteReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,15 +11305,15 @@ func (m *GetRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromoteReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromoteReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1156,7 +11341,7 @@ func (m *GetRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.NodeID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1179,7 +11364,7 @@ func (m *GetRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetReq) Unmarshal(dAtA []byte) error {
+func (m *PromoteRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1202,76 +11387,12 @@ func (m *SetReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromoteRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromoteRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRpcService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRpcService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRpcService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRpcService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcService(dAtA[iNdEx:])
@@ -1293,7 +11414,7 @@ func (m *SetReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetRsp) Unmarshal(dAtA []byte) error {
+func (m *StatusReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1316,10 +11437,10 @@ func (m *SetRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1343,7 +11464,7 @@ func (m *SetRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteReq) Unmarshal(dAtA []byte) error {
+func (m *StatusRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1366,15 +11487,15 @@ func (m *DeleteReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1402,8 +11523,78 @@ func (m *DeleteReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
+			}
+			m.AppliedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastLogIndex", wireType)
+			}
+			m.LastLogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastLogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcService(dAtA[iNdEx:])
@@ -1425,7 +11616,7 @@ func (m *DeleteReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRsp) Unmarshal(dAtA []byte) error {
+func (m *ListMembersReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1448,10 +11639,10 @@ func (m *DeleteRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListMembersReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListMembersReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1475,7 +11666,7 @@ func (m *DeleteRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JoinReq) Unmarshal(dAtA []byte) error {
+func (m *Member) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1498,15 +11689,15 @@ func (m *JoinReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JoinReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GrpcAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1534,7 +11725,7 @@ func (m *JoinReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GrpcAddr = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1570,7 +11761,7 @@ func (m *JoinReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrpcAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1598,8 +11789,60 @@ func (m *JoinReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeID = string(dAtA[iNdEx:postIndex])
+			m.GrpcAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffrage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suffrage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Leader = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcService(dAtA[iNdEx:])
@@ -1621,7 +11864,7 @@ func (m *JoinReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JoinRsp) Unmarshal(dAtA []byte) error {
+func (m *ListMembersRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1644,12 +11887,46 @@ func (m *JoinRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JoinRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListMembersRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListMembersRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcService(dAtA[iNdEx:])
@@ -9,12 +9,15 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"raft-grpc-demo/client"
 	"raft-grpc-demo/ecode"
 	rpcservicepb "raft-grpc-demo/proto"
 	"strings"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type centerForRegister struct {
@@ -23,6 +26,8 @@ type centerForRegister struct {
 	services map[string]struct{}
 	ln       net.Listener
 	logger   *log.Logger
+	// redirect follows the leader hints of nodes in redirect mode
+	redirect *client.LeaderRedirect
 }
 
 var rpcClient rpcservicepb.RpcServiceClient
@@ -33,6 +38,7 @@ func NewCenterForRegister(addr string) *centerForRegister {
 		addr:     addr,
 		services: map[string]struct{}{},
 		logger:   log.New(os.Stderr, "[RegisterCenter Service]", log.LstdFlags),
+		redirect: client.NewLeaderRedirect(),
 	}
 }
 
@@ -80,12 +86,59 @@ func (c *centerForRegister) ServeHTTP(w http.ResponseWriter, req *http.Request)
 				return
 			}
 			w.WriteHeader(http.StatusOK)
+		case "PUT":
+			// conditional write, ?prevValue=<v> swaps only if the key holds v,
+			// ?prevExist=false sets only if the key does not exist yet
+			k := getKey()
+			if k == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			m := map[string]string{}
+			if err := json.NewDecoder(req.Body).Decode(&m); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			query := req.URL.Query()
+			prevValue, hasPrevValue := query["prevValue"]
+			var succeeded bool
+			var err error
+			switch {
+			case query.Get("prevExist") == "false":
+				succeeded, err = c.doSetIfAbsent(k, m["value"])
+			case hasPrevValue:
+				succeeded, err = c.doCompareAndSwap(k, prevValue[0], m["value"])
+			default:
+				succeeded, err = true, c.doSet(k, m["value"])
+			}
+			if err != nil {
+				c.logger.Printf("put key %s fail %v", k, err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if !succeeded {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
 		case "DELETE":
 			k := getKey()
 			if k == "" {
 				w.WriteHeader(http.StatusBadRequest)
 				return
 			}
+			if prevValue, ok := req.URL.Query()["prevValue"]; ok {
+				succeeded, err := c.doDeleteIfValue(k, prevValue[0])
+				if err != nil {
+					c.logger.Printf("delete key %s fail %v", k, err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				if !succeeded {
+					w.WriteHeader(http.StatusPreconditionFailed)
+				}
+				return
+			}
 			err := c.doDelete(k)
 			if err != nil {
 				c.logger.Printf("delete key %s fail %v", k, err)
@@ -96,11 +149,50 @@ func (c *centerForRegister) ServeHTTP(w http.ResponseWriter, req *http.Request)
 		}
 	} else if strings.HasPrefix(req.URL.Path, "/service_join") {
 		c.serviceRegister(w, req)
+	} else if strings.HasPrefix(req.URL.Path, "/transfer_leadership") {
+		// ?id=<node id> picks the new leader, any voter otherwise
+		if req.Method != "POST" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err := c.doTransferLeadership(req.Context(), req.URL.Query().Get("id")); err != nil {
+			c.logger.Printf("transfer leadership fail %v", err)
+			if status.Code(err) == codes.NotFound {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	} else if strings.HasPrefix(req.URL.Path, "/members") {
+		c.members(w, req)
+	} else if strings.HasPrefix(req.URL.Path, "/backup") {
+		if req.Method != "GET" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		if err := c.doBackup(req.Context(), w); err != nil {
+			// Once data was sent the status can no longer change, the
+			// truncated body fails the size check of the restore.
+			c.logger.Printf("backup fail %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
 	} else {
 		w.WriteHeader(http.StatusNotFound)
 	}
 }
 
+// dialOptions are the options to dial the registered nodes with. Leader
+// hints of nodes in redirect mode are followed.
+func (c *centerForRegister) dialOptions() []grpc.DialOption {
+	return append(c.redirect.DialOptions(),
+		grpc.WithInsecure(),
+		grpc.WithBlock(),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"pick_first"}`))
+}
+
 func (c *centerForRegister) dialRegisteredAddress() error {
 	var err error
 	var targetAddr = ""
@@ -110,7 +202,7 @@ func (c *centerForRegister) dialRegisteredAddress() error {
 		}
 		timeCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
-		c.conn, err = grpc.DialContext(timeCtx, targetAddr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"pick_first"}`))
+		c.conn, err = grpc.DialContext(timeCtx, targetAddr, c.dialOptions()...)
 		if err != nil {
 			return err
 		}
@@ -132,7 +224,7 @@ func (c *centerForRegister) dialRegisteredAddress() error {
 	fmt.Println(targetAddr)
 	timeCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
-	c.conn, err = grpc.DialContext(timeCtx, targetAddr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy":"pick_first"}`))
+	c.conn, err = grpc.DialContext(timeCtx, targetAddr, c.dialOptions()...)
 	if err != nil {
 		return err
 	}
@@ -143,6 +235,20 @@ func (c *centerForRegister) dialRegisteredAddress() error {
 	return nil
 }
 
+// client returns the client of the registered nodes, dialing them first if
+// needed.
+func (c *centerForRegister) client() (rpcservicepb.RpcServiceClient, error) {
+	if c.conn == nil {
+		if err := c.dialRegisteredAddress(); err != nil {
+			return nil, err
+		}
+	}
+	if rpcClient == nil {
+		rpcClient = rpcservicepb.NewRpcServiceClient(c.conn)
+	}
+	return rpcClient, nil
+}
+
 func (c *centerForRegister) serviceRegister(w http.ResponseWriter, req *http.Request) {
 	switch req.Method {
 	case "POST":
@@ -161,12 +267,87 @@ func (c *centerForRegister) serviceRegister(w http.ResponseWriter, req *http.Req
 		rpcClient = rpcservicepb.NewRpcServiceClient(c.conn)
 		log.Printf("server join addr: %s", addr)
 		w.WriteHeader(http.StatusOK)
+	case "DELETE":
+		// a node shutting down removes its address
+		m := map[string]string{}
+		if err := json.NewDecoder(req.Body).Decode(&m); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		addr := m["serviceAddr"]
+		if _, ok := c.services[addr]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		c.removeService(addr)
+		if c.conn != nil {
+			c.conn.Close()
+			c.conn = nil
+			rpcClient = nil
+		}
+		log.Printf("server leave addr: %s", addr)
+		if len(c.services) > 0 {
+			if err := c.dialRegisteredAddress(); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			rpcClient = rpcservicepb.NewRpcServiceClient(c.conn)
+		}
+		w.WriteHeader(http.StatusOK)
 	default:
 		w.WriteHeader(http.StatusServiceUnavailable)
 	}
 
 }
 
+// members serves the cluster membership:
+//
+//	GET    /members                 lists the members
+//	DELETE /members/<id>            removes a node
+//	POST   /members/<id>/demote     turns a voter into a nonvoter
+//	POST   /members/<id>/promote    turns a caught up nonvoter into a voter
+func (c *centerForRegister) members(w http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	var err error
+	switch {
+	case len(parts) == 1 && req.Method == "GET":
+		var members []*rpcservicepb.Member
+		if members, err = c.doListMembers(req.Context()); err == nil {
+			b, err := json.Marshal(members)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(b)
+			return
+		}
+	case len(parts) == 2 && req.Method == "DELETE":
+		err = c.doRemoveNode(req.Context(), parts[1])
+	case len(parts) == 3 && parts[2] == "demote" && req.Method == "POST":
+		err = c.doDemoteVoter(req.Context(), parts[1])
+	case len(parts) == 3 && parts[2] == "promote" && req.Method == "POST":
+		err = c.doPromote(req.Context(), parts[1])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		c.logger.Printf("%s %s fail %v", req.Method, req.URL.Path, err)
+		switch status.Code(err) {
+		case codes.NotFound:
+			w.WriteHeader(http.StatusNotFound)
+		case codes.FailedPrecondition:
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		io.WriteString(w, status.Convert(err).Message())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
 func (c *centerForRegister) Start() error {
 	if len(c.addr) == 0 {
 		log.Fatalf("raft client addr is required")
@@ -237,3 +418,110 @@ func (c *centerForRegister) doDelete(key string) error {
 	}
 	return nil
 }
+
+func (c *centerForRegister) doCompareAndSwap(key, expected, value string) (bool, error) {
+	cli, err := c.client()
+	if err != nil {
+		return false, err
+	}
+	rsp, err := cli.CompareAndSwap(context.Background(), &rpcservicepb.CompareAndSwapReq{Key: key, Expected: expected, Value: value})
+	if err != nil {
+		return false, err
+	}
+	return rsp.Succeeded, nil
+}
+
+func (c *centerForRegister) doSetIfAbsent(key, value string) (bool, error) {
+	cli, err := c.client()
+	if err != nil {
+		return false, err
+	}
+	rsp, err := cli.SetIfAbsent(context.Background(), &rpcservicepb.SetIfAbsentReq{Key: key, Value: value})
+	if err != nil {
+		return false, err
+	}
+	return rsp.Succeeded, nil
+}
+
+func (c *centerForRegister) doDeleteIfValue(key, expected string) (bool, error) {
+	cli, err := c.client()
+	if err != nil {
+		return false, err
+	}
+	rsp, err := cli.DeleteIfValue(context.Background(), &rpcservicepb.DeleteIfValueReq{Key: key, Expected: expected})
+	if err != nil {
+		return false, err
+	}
+	return rsp.Succeeded, nil
+}
+
+func (c *centerForRegister) doTransferLeadership(ctx context.Context, nodeID string) error {
+	cli, err := c.client()
+	if err != nil {
+		return err
+	}
+	_, err = cli.TransferLeadership(ctx, &rpcservicepb.TransferLeadershipReq{NodeID: nodeID})
+	return err
+}
+
+func (c *centerForRegister) doRemoveNode(ctx context.Context, nodeID string) error {
+	cli, err := c.client()
+	if err != nil {
+		return err
+	}
+	_, err = cli.RemoveNode(ctx, &rpcservicepb.RemoveNodeReq{NodeID: nodeID})
+	return err
+}
+
+func (c *centerForRegister) doDemoteVoter(ctx context.Context, nodeID string) error {
+	cli, err := c.client()
+	if err != nil {
+		return err
+	}
+	_, err = cli.DemoteVoter(ctx, &rpcservicepb.DemoteVoterReq{NodeID: nodeID})
+	return err
+}
+
+func (c *centerForRegister) doPromote(ctx context.Context, nodeID string) error {
+	cli, err := c.client()
+	if err != nil {
+		return err
+	}
+	_, err = cli.Promote(ctx, &rpcservicepb.PromoteReq{NodeID: nodeID})
+	return err
+}
+
+func (c *centerForRegister) doListMembers(ctx context.Context) ([]*rpcservicepb.Member, error) {
+	cli, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := cli.ListMembers(ctx, &rpcservicepb.ListMembersReq{})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Members, nil
+}
+
+func (c *centerForRegister) doBackup(ctx context.Context, w io.Writer) error {
+	cli, err := c.client()
+	if err != nil {
+		return err
+	}
+	stream, err := cli.Backup(ctx, &rpcservicepb.BackupReq{})
+	if err != nil {
+		return err
+	}
+	for {
+		rsp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(rsp.Data); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	rpcservicepb "raft-grpc-demo/proto"
+)
+
+// idleConnTimeout is how long a connection to another node is kept without
+// RPCs, e.g. to a node that is no longer the leader.
+const idleConnTimeout = 5 * time.Minute
+
+// connPool holds one client connection per node address, shared by every
+// RPC forwarded by the server. It is safe for concurrent use.
+//
+// Connections are dialed without blocking: the dial returns at once and the
+// first RPC waits for the connection to be ready. grpc reconnects on its own
+// after a failure, so a connection is only closed once it carried no RPC for
+// idleConnTimeout, or when the pool is closed. RPCs in flight, such as long
+// backup streams, keep their connection open.
+type connPool struct {
+	mu     sync.Mutex
+	conns  map[string]*pooledConn
+	closed bool
+	done   chan struct{}
+}
+
+type pooledConn struct {
+	cc       *grpc.ClientConn
+	inflight int       // RPCs using cc, see get
+	lastUsed time.Time // end of the last RPC
+}
+
+func newConnPool() *connPool {
+	p := &connPool{
+		conns: make(map[string]*pooledConn),
+		done:  make(chan struct{}),
+	}
+	go p.reapIdle()
+	return p
+}
+
+// get returns the connection to addr for one RPC, dialing it on first use.
+// release must be called once the RPC ended, the connection is not reaped
+// before.
+func (p *connPool) get(addr string) (cc *grpc.ClientConn, release func(), err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, nil, grpc.ErrClientConnClosing
+	}
+	c, ok := p.conns[addr]
+	if !ok || c.cc.GetState() == connectivity.Shutdown {
+		cc, err := grpc.Dial(addr, grpc.WithInsecure())
+		if err != nil {
+			return nil, nil, err
+		}
+		c = &pooledConn{cc: cc}
+		p.conns[addr] = c
+	}
+
+	c.inflight++
+	var once sync.Once
+	release = func() {
+		once.Do(func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			c.inflight--
+			c.lastUsed = time.Now()
+		})
+	}
+	return c.cc, release, nil
+}
+
+// client returns an RpcServiceClient over the connection to addr, see get
+func (p *connPool) client(addr string) (rpcservicepb.RpcServiceClient, func(), error) {
+	cc, release, err := p.get(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rpcservicepb.NewRpcServiceClient(cc), release, nil
+}
+
+func (p *connPool) reapIdle() {
+	tck := time.NewTicker(idleConnTimeout / 2)
+	defer tck.Stop()
+
+	for {
+		select {
+		case <-tck.C:
+		case <-p.done:
+			return
+		}
+
+		p.reap(idleConnTimeout)
+	}
+}
+
+// reap closes the connections that carried no RPC for longer than idle
+func (p *connPool) reap(idle time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for addr, c := range p.conns {
+		if c.inflight == 0 && time.Since(c.lastUsed) > idle {
+			c.cc.Close()
+			delete(p.conns, addr)
+		}
+	}
+}
+
+// close closes every connection. RPCs still running on them fail.
+func (p *connPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return
+	}
+	p.closed = true
+	close(p.done)
+	for addr, c := range p.conns {
+		c.cc.Close()
+		delete(p.conns, addr)
+	}
+}
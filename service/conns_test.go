@@ -0,0 +1,70 @@
+package service
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+func TestConnPool(t *testing.T) {
+	p := newConnPool()
+
+	// Parallel forwards to the same node share one connection.
+	var wg sync.WaitGroup
+	conns := make([]*grpc.ClientConn, 16)
+	for i := range conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cc, release, err := p.get("127.0.0.1:1")
+			assert.Nil(t, err)
+			release()
+			conns[i] = cc
+		}(i)
+	}
+	wg.Wait()
+	for _, cc := range conns {
+		assert.Same(t, conns[0], cc)
+	}
+
+	other, release, err := p.get("127.0.0.1:2")
+	assert.Nil(t, err)
+	release()
+	assert.NotSame(t, conns[0], other)
+
+	// A connection closed behind the pool's back is replaced.
+	other.Close()
+	cc, release, err := p.get("127.0.0.1:2")
+	assert.Nil(t, err)
+	release()
+	assert.NotSame(t, other, cc)
+
+	p.close()
+	_, _, err = p.get("127.0.0.1:1")
+	assert.NotNil(t, err)
+}
+
+func TestConnPoolReap(t *testing.T) {
+	p := newConnPool()
+	defer p.close()
+
+	busy, release, err := p.get("127.0.0.1:1")
+	assert.Nil(t, err)
+	idle, releaseIdle, err := p.get("127.0.0.1:2")
+	assert.Nil(t, err)
+	releaseIdle()
+
+	// A connection carrying an RPC, e.g. a long backup stream, is kept
+	// however long ago it was taken.
+	p.reap(0)
+	assert.Equal(t, connectivity.Shutdown, idle.GetState())
+	assert.NotEqual(t, connectivity.Shutdown, busy.GetState())
+
+	release()
+	release() // releasing twice counts once
+	p.reap(0)
+	assert.Equal(t, connectivity.Shutdown, busy.GetState())
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"io"
+	"raft-grpc-demo/core"
+	"raft-grpc-demo/ecode"
+	rpcservicepb "raft-grpc-demo/proto"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Followers proxy the RPCs only the leader can serve. A handler tells so by
+// returning core.ErrNotLeader, the interceptors below then send the request
+// to the leader and relay its response. Any RPC of RpcService is forwarded
+// this way without further code. In ForwardRedirect mode, the caller is
+// sent to the leader instead.
+
+// ForwardMode is how followers answer the RPCs only the leader serves
+type ForwardMode string
+
+const (
+	// ForwardProxy sends the request to the leader and relays its response
+	ForwardProxy ForwardMode = "proxy"
+	// ForwardRedirect fails the request with ecode.NotLeader, naming the
+	// leader for the caller to retry against. This saves a hop but needs a
+	// client following the hint, see client.LeaderRedirect.
+	ForwardRedirect ForwardMode = "redirect"
+)
+
+const (
+	// forwardHopsKey is the metadata key counting how often a request was
+	// forwarded.
+	forwardHopsKey = "x-forward-hops"
+
+	// maxForwardHops bounds the forwarding of a request. The first hop
+	// reaches the leader, the second one covers a leadership change on the
+	// way. Nodes disagreeing on the leader fail the request instead of
+	// passing it around.
+	maxForwardHops = 2
+
+	// forwardTimeout bounds forwarded unary RPCs without a deadline
+	forwardTimeout = 10 * time.Second
+)
+
+var serverType = reflect.TypeOf((*rpcservicepb.RpcServiceServer)(nil)).Elem()
+
+// serviceMethod returns the method of RpcServiceServer called by fullMethod
+func serviceMethod(fullMethod string) (reflect.Method, bool) {
+	return serverType.MethodByName(fullMethod[strings.LastIndex(fullMethod, "/")+1:])
+}
+
+// notLeader is the error of RPCs only the leader serves in ForwardRedirect
+// mode.
+func (s *Server) notLeader() error {
+	leaderGrpcAddr := s.store.LeaderAPIAddr()
+	if leaderGrpcAddr == "" || leaderGrpcAddr == s.addr {
+		return ecode.ServiceUnavailable
+	}
+	return ecode.NotLeader(leaderGrpcAddr)
+}
+
+// leaderConn returns the connection to the leader and the context to send a
+// request forwarded from ctx with. release is called once the request ended,
+// see connPool.get.
+func (s *Server) leaderConn(ctx context.Context) (cc *grpc.ClientConn, fwdCtx context.Context, release func(), err error) {
+	hops := 0
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(forwardHopsKey); len(v) > 0 {
+			hops, _ = strconv.Atoi(v[0])
+		}
+	}
+	if hops >= maxForwardHops {
+		return nil, nil, nil, status.Errorf(codes.Unavailable, "not forwarded after %d hops, no leader reachable", hops)
+	}
+
+	leaderGrpcAddr := s.store.LeaderAPIAddr()
+	if leaderGrpcAddr == "" || leaderGrpcAddr == s.addr {
+		return nil, nil, nil, ecode.ServiceUnavailable
+	}
+	cc, release, err = s.conns.get(leaderGrpcAddr)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return cc, metadata.AppendToOutgoingContext(ctx, forwardHopsKey, strconv.Itoa(hops+1)), release, nil
+}
+
+// forwardUnary is the grpc.UnaryServerInterceptor forwarding unary RPCs
+func (s *Server) forwardUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	rsp, err := handler(ctx, req)
+	if err != core.ErrNotLeader {
+		return rsp, err
+	}
+	if s.mode == ForwardRedirect {
+		return nil, s.notLeader()
+	}
+	m, ok := serviceMethod(info.FullMethod)
+	if !ok {
+		return nil, err
+	}
+
+	cc, ctx, release, err := s.leaderConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, forwardTimeout)
+		defer cancel()
+	}
+	// Method(ctx, *Req) (*Rsp, error)
+	reply := reflect.New(m.Type.Out(0).Elem()).Interface()
+	if err := cc.Invoke(ctx, info.FullMethod, req, reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// forwardStream is the grpc.StreamServerInterceptor forwarding streaming
+// RPCs. The messages the handler received are sent to the leader again, a
+// stream the handler already sent on is not forwarded.
+func (s *Server) forwardStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	rs := &recordingStream{ServerStream: ss}
+	err := handler(srv, rs)
+	if err != core.ErrNotLeader || rs.sent {
+		return err
+	}
+	if s.mode == ForwardRedirect {
+		return s.notLeader()
+	}
+	m, ok := serviceMethod(info.FullMethod)
+	if !ok {
+		return err
+	}
+
+	cc, ctx, release, err := s.leaderConn(ss.Context())
+	if err != nil {
+		return err
+	}
+	defer release()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	desc := &grpc.StreamDesc{
+		StreamName:    m.Name,
+		ServerStreams: info.IsServerStream,
+		ClientStreams: info.IsClientStream,
+	}
+	cs, err := cc.NewStream(ctx, desc, info.FullMethod)
+	if err != nil {
+		return err
+	}
+	for _, msg := range rs.received {
+		if err := cs.SendMsg(msg); err != nil {
+			return err
+		}
+	}
+
+	// Method([*Req, ]RpcService_MethodServer) error
+	stream := m.Type.In(m.Type.NumIn() - 1)
+	if info.IsClientStream {
+		recv, _ := stream.MethodByName("Recv")
+		go relayRequests(ss, cs, recv.Type.Out(0).Elem(), cancel)
+	} else if err := cs.CloseSend(); err != nil {
+		return err
+	}
+	send, _ := stream.MethodByName("Send")
+	replyType := send.Type.In(0).Elem()
+	for {
+		reply := reflect.New(replyType).Interface()
+		if err := cs.RecvMsg(reply); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := ss.SendMsg(reply); err != nil {
+			return err
+		}
+	}
+}
+
+// relayRequests sends the requests still coming from the caller to the
+// leader. The forwarded stream is cancelled if the caller fails.
+func relayRequests(ss grpc.ServerStream, cs grpc.ClientStream, reqType reflect.Type, cancel context.CancelFunc) {
+	for {
+		req := reflect.New(reqType).Interface()
+		if err := ss.RecvMsg(req); err == io.EOF {
+			cs.CloseSend()
+			return
+		} else if err != nil {
+			cancel()
+			return
+		}
+		if err := cs.SendMsg(req); err != nil {
+			return
+		}
+	}
+}
+
+// recordingStream keeps the messages received by a handler and whether it
+// sent any.
+type recordingStream struct {
+	grpc.ServerStream
+	received []interface{}
+	sent     bool
+}
+
+func (r *recordingStream) RecvMsg(m interface{}) error {
+	if err := r.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	r.received = append(r.received, m)
+	return nil
+}
+
+func (r *recordingStream) SendMsg(m interface{}) error {
+	r.sent = true
+	return r.ServerStream.SendMsg(m)
+}
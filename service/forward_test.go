@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"raft-grpc-demo/client"
+	"raft-grpc-demo/core"
+	"raft-grpc-demo/ecode"
+	rpcservicepb "raft-grpc-demo/proto"
+)
+
+// fakeStore answers Set, Backup and ReadIndex like the leader, or with
+// ErrNotLeader naming leader as the leader. It serves stale reads of any key
+// with value, and has applied the log up to applied. It is lag entries and
+// staleness behind the leader, or fails the staleness check with checkErr.
+type fakeStore struct {
+	StoreApi
+	leader    string
+	isLead    bool
+	readIndex uint64
+	applied   uint64
+	value     string
+	lag       uint64
+	staleness time.Duration
+	checkErr  error
+}
+
+func (f *fakeStore) LeaderAPIAddr() string { return f.leader }
+
+func (f *fakeStore) SetWithOptions(key, value string, opts core.WriteOptions) (*core.WriteResult, error) {
+	if !f.isLead {
+		return nil, core.ErrNotLeader
+	}
+	return &core.WriteResult{Succeeded: true, Revision: 7}, nil
+}
+
+func (f *fakeStore) ReadIndex() (uint64, error) {
+	if !f.isLead {
+		return 0, core.ErrNotLeader
+	}
+	return f.readIndex, nil
+}
+
+func (f *fakeStore) WaitForAppliedIndex(idx uint64, timeout time.Duration) error {
+	if f.applied < idx {
+		return fmt.Errorf("index %d not applied", idx)
+	}
+	return nil
+}
+
+func (f *fakeStore) CheckStaleness(bound core.StalenessBound) error {
+	if f.checkErr != nil {
+		return f.checkErr
+	}
+	if (bound.MaxLagEntries > 0 && f.lag > bound.MaxLagEntries) ||
+		(bound.MaxStaleness > 0 && f.staleness > bound.MaxStaleness) {
+		return &core.StaleReadError{LagEntries: f.lag, Staleness: f.staleness}
+	}
+	return nil
+}
+
+func (f *fakeStore) GetKV(key string, level core.ConsistencyLevel) (*core.KeyValue, error) {
+	if level != core.Stale && !f.isLead {
+		return nil, core.ErrNotLeader
+	}
+	return &core.KeyValue{Key: key, Value: f.value}, nil
+}
+
+func (f *fakeStore) Backup(w io.Writer) error {
+	if !f.isLead {
+		return core.ErrNotLeader
+	}
+	_, err := w.Write([]byte("backup"))
+	return err
+}
+
+// startServer serves store on a free port and returns its address
+func startServer(t *testing.T, store *fakeStore, mode ForwardMode) (string, rpcservicepb.RpcServiceClient) {
+	srv, err := NewGrpcServerAndStart("127.0.0.1:0", store, mode)
+	assert.Nil(t, err)
+	addr := srv.ln.Addr().String()
+	cc, err := grpc.Dial(addr, grpc.WithInsecure())
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		cc.Close()
+		srv.Stop(time.Second)
+	})
+	return addr, rpcservicepb.NewRpcServiceClient(cc)
+}
+
+func TestForward(t *testing.T) {
+	leader, _ := startServer(t, &fakeStore{isLead: true}, ForwardProxy)
+	_, follower := startServer(t, &fakeStore{leader: leader}, ForwardProxy)
+
+	t.Run("unary", func(t *testing.T) {
+		rsp, err := follower.Set(context.Background(), &rpcservicepb.SetReq{Key: "a", Value: "1"})
+		assert.Nil(t, err)
+		assert.Equal(t, &rpcservicepb.SetRsp{Succeeded: true, Revision: 7}, rsp)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		stream, err := follower.Backup(context.Background(), &rpcservicepb.BackupReq{})
+		assert.Nil(t, err)
+		rsp, err := stream.Recv()
+		assert.Nil(t, err)
+		assert.Equal(t, "backup", string(rsp.Data))
+		_, err = stream.Recv()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("loop", func(t *testing.T) {
+		// Two followers taking each other for the leader give up after
+		// maxForwardHops.
+		a := &fakeStore{}
+		b := &fakeStore{}
+		addrA, clientA := startServer(t, a, ForwardProxy)
+		addrB, _ := startServer(t, b, ForwardProxy)
+		a.leader, b.leader = addrB, addrA
+
+		_, err := clientA.Set(context.Background(), &rpcservicepb.SetReq{Key: "a", Value: "1"})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+	t.Run("redirect", func(t *testing.T) {
+		_, follower := startServer(t, &fakeStore{leader: leader}, ForwardRedirect)
+		_, err := follower.Set(context.Background(), &rpcservicepb.SetReq{Key: "a", Value: "1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		hint, ok := ecode.LeaderHint(err)
+		assert.True(t, ok)
+		assert.Equal(t, leader, hint)
+	})
+
+	t.Run("redirect followed", func(t *testing.T) {
+		addr, _ := startServer(t, &fakeStore{leader: leader}, ForwardRedirect)
+		redirect := client.NewLeaderRedirect()
+		defer redirect.Close()
+		cc, err := grpc.Dial(addr, append(redirect.DialOptions(), grpc.WithInsecure())...)
+		assert.Nil(t, err)
+		defer cc.Close()
+		follower := rpcservicepb.NewRpcServiceClient(cc)
+
+		rsp, err := follower.Set(context.Background(), &rpcservicepb.SetReq{Key: "a", Value: "1"})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(7), rsp.Revision)
+		assert.Equal(t, leader, redirect.Leader())
+
+		stream, err := follower.Backup(context.Background(), &rpcservicepb.BackupReq{})
+		assert.Nil(t, err)
+		bk, err := stream.Recv()
+		assert.Nil(t, err)
+		assert.Equal(t, "backup", string(bk.Data))
+	})
+	t.Run("redirect reads per call", func(t *testing.T) {
+		leader, _ := startServer(t, &fakeStore{isLead: true, value: "leader"}, ForwardProxy)
+		addr, _ := startServer(t, &fakeStore{leader: leader, value: "local"}, ForwardRedirect)
+		redirect := client.NewLeaderRedirect()
+		defer redirect.Close()
+		cc, err := grpc.Dial(addr, append(redirect.DialOptions(), grpc.WithInsecure())...)
+		assert.Nil(t, err)
+		defer cc.Close()
+		follower := rpcservicepb.NewRpcServiceClient(cc)
+
+		// A redirected default read does not send the stale ones to the
+		// leader.
+		rsp, err := follower.Get(context.Background(), &rpcservicepb.GetReq{Key: "a"})
+		assert.Nil(t, err)
+		assert.Equal(t, "leader", rsp.Value)
+		rsp, err = follower.Get(context.Background(), &rpcservicepb.GetReq{Key: "a", Level: "stale"})
+		assert.Nil(t, err)
+		assert.Equal(t, "local", rsp.Value)
+	})
+
+	t.Run("redirect failover", func(t *testing.T) {
+		old, err := NewGrpcServerAndStart("127.0.0.1:0", &fakeStore{isLead: true}, ForwardRedirect)
+		assert.Nil(t, err)
+		store := &fakeStore{leader: old.ln.Addr().String()}
+		addr, _ := startServer(t, store, ForwardRedirect)
+		redirect := client.NewLeaderRedirect()
+		defer redirect.Close()
+		cc, err := grpc.Dial(addr, append(redirect.DialOptions(), grpc.WithInsecure())...)
+		assert.Nil(t, err)
+		defer cc.Close()
+		follower := rpcservicepb.NewRpcServiceClient(cc)
+
+		_, err = follower.Set(context.Background(), &rpcservicepb.SetReq{Key: "a", Value: "1"})
+		assert.Nil(t, err)
+		assert.Equal(t, store.leader, redirect.Leader())
+
+		// The cached leader dies, the write goes to the node dialed again
+		// and follows its hint to the new leader.
+		old.Stop(time.Second)
+		leader, _ := startServer(t, &fakeStore{isLead: true}, ForwardRedirect)
+		store.leader = leader
+		rsp, err := follower.Set(context.Background(), &rpcservicepb.SetReq{Key: "a", Value: "2"})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(7), rsp.Revision)
+		assert.Equal(t, leader, redirect.Leader())
+	})
+}
@@ -1,7 +1,10 @@
 package service
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"io"
 	"log"
 	"net"
 	"os"
@@ -11,19 +14,63 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 //StoreApi is interface declaration. Its realization is in core/api.go
 type StoreApi interface {
-	Get(key string, level core.ConsistencyLevel) (string, error)
+	GetKV(key string, level core.ConsistencyLevel) (*core.KeyValue, error)
 
-	Set(key, value string) error
+	Range(opts core.RangeOptions, level core.ConsistencyLevel) (*core.RangeResult, error)
 
-	Delete(key string) error
+	SetWithOptions(key, value string, opts core.WriteOptions) (*core.WriteResult, error)
 
-	Join(nodeID, grpcAddr, raftAddr string) error
+	DeleteWithOptions(key string, opts core.WriteOptions) (*core.WriteResult, error)
+
+	ApplyBatch(ops []core.BatchOp) error
+
+	Txn(compares []core.Compare, success, failure []core.BatchOp) (*core.TxnResult, error)
+
+	CompareAndSwap(key, expected, value string) (bool, error)
+
+	SetIfAbsent(key, value string) (bool, error)
+
+	DeleteIfValue(key, expected string) (bool, error)
+
+	GrantLease(ttl time.Duration) (int64, error)
+
+	KeepAliveLease(id int64) (time.Duration, error)
+
+	RevokeLease(id int64) error
+
+	Watch(key string, prefix bool, startRevision uint64) (*core.Watcher, error)
+
+	Join(nodeID, grpcAddr, raftAddr string, nonvoter bool) error
 
 	LeaderAPIAddr() string
+
+	ReadIndex() (uint64, error)
+
+	WaitForAppliedIndex(idx uint64, timeout time.Duration) error
+
+	CheckStaleness(bound core.StalenessBound) error
+
+	Backup(w io.Writer) error
+
+	TakeSnapshot() (*core.SnapshotInfo, error)
+
+	TransferLeadership(nodeID string) error
+
+	RemoveNode(nodeID string) error
+
+	DemoteVoter(nodeID string) error
+
+	Members() ([]core.Member, error)
+
+	Promote(nodeID string, applied uint64) error
+
+	Status() core.NodeStatus
 }
 
 //NewServer return server with raft service
@@ -33,275 +80,575 @@ func NewServer(store StoreApi, addr string, ln net.Listener) *Server {
 		store:  store,
 		ln:     ln,
 		logger: log.New(os.Stderr, "[grpc Service]", log.LstdFlags),
+		conns:  newConnPool(),
 	}
 }
 
-const (
-	GetTypeID = int64(iota)
-	SetTypeID
-	JoinTypeID
-	DeleteTypeID
-)
+// readIndexTimeout bounds how long a follower waits to apply the leader's
+// commit index before serving a consistent read.
+const readIndexTimeout = 5 * time.Second
+
+// backupChunkSize is the size of the data carried by each BackupRsp
+const backupChunkSize = 64 << 10
 
 type Server struct {
 	addr   string
 	store  StoreApi
 	ln     net.Listener
 	logger *log.Logger
-	//leadClient rpcservicepb.RpcServiceClient
-	leaderConn *grpc.ClientConn
+	grpc   *grpc.Server
+	// conns holds the connections to other nodes used to forward RPCs
+	conns *connPool
+	mode  ForwardMode
 }
 
 var _ rpcservicepb.RpcServiceServer = (*Server)(nil) // 检查是否实现所有方法
-var rpcserviceClient rpcservicepb.RpcServiceClient
 
-func NewGrpcServerAndStart(addr string, api StoreApi) error {
-	grpcSrv := grpc.NewServer()
+func NewGrpcServerAndStart(addr string, api StoreApi, mode ForwardMode, opts ...grpc.ServerOption) (*Server, error) {
 	network := "tcp"
 	ln, err := net.Listen(network, addr)
-	srv := NewServer(api, addr, ln)
-	rpcservicepb.RegisterRpcServiceServer(grpcSrv, srv)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	srv := NewServer(api, addr, ln)
+	srv.mode = mode
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(srv.forwardUnary),
+		grpc.ChainStreamInterceptor(srv.forwardStream))
+	grpcSrv := grpc.NewServer(opts...)
+	srv.grpc = grpcSrv
+	rpcservicepb.RegisterRpcServiceServer(grpcSrv, srv)
 	go func() {
 		if err := grpcSrv.Serve(ln); err != nil {
 			log.Panic("socket listener accept net conn failed", err.Error())
 		}
 	}()
-	return nil
+	return srv, nil
 }
 
 func (s *Server) Close() {
 	s.ln.Close()
 }
 
+//Stop stops accepting RPCs and waits up to timeout for the running ones to
+//finish. RPCs still running then, such as watch streams, are cancelled.
+func (s *Server) Stop(timeout time.Duration) {
+	drained := make(chan struct{})
+	go func() {
+		s.grpc.GracefulStop()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-time.After(timeout):
+		s.logger.Printf("rpcs still running after %s, cancel them", timeout)
+		s.grpc.Stop()
+		<-drained
+	}
+	s.conns.close()
+}
+
 func (s *Server) Get(ctx context.Context, req *rpcservicepb.GetReq) (*rpcservicepb.GetRsp, error) {
 	if req.Key == "" {
 		return nil, ecode.BadRequest
 	}
-	var consLv core.ConsistencyLevel
-	switch req.Level {
-	case "default":
-		consLv = core.Default
-	case "stale":
-		consLv = core.Stale
-	case "consistent":
-		consLv = core.Consistent
-	default:
-		consLv = core.Default
+	if req.Level == "bounded" {
+		if err := s.checkBounded(req.MaxLagEntries, req.MaxStalenessMs); err != nil {
+			return nil, err
+		}
+	}
+	level := parseLevel(req.Level)
+	kv, err := s.store.GetKV(req.Key, level)
+	if err == core.ErrNotLeader && level == core.Consistent {
+		if err := s.waitForReadIndex(ctx); err != nil {
+			return nil, err
+		}
+		kv, err = s.store.GetKV(req.Key, core.Stale)
 	}
-	value, err := s.store.Get(req.Key, consLv)
 	if err != nil {
 		if err == core.ErrNotLeader {
-			rsp, err := s.verifyLeaderConnReDial(ctx, req, GetTypeID)
-			if err != nil {
-				return nil, err
-			}
-			return rsp.(*rpcservicepb.GetRsp), nil
+			return nil, err
 		}
 		return nil, ecode.InternalServerError
 	}
-	return &rpcservicepb.GetRsp{Value: value}, nil
+	if kv == nil {
+		return &rpcservicepb.GetRsp{}, nil
+	}
+	return &rpcservicepb.GetRsp{
+		Value:          kv.Value,
+		CreateRevision: kv.CreateRevision,
+		ModRevision:    kv.ModRevision,
+		Version:        kv.Version,
+		Lease:          kv.Lease,
+	}, nil
 }
 
-func (s *Server) get(ctx context.Context, leaderGrpcAddr, key string) (*rpcservicepb.GetRsp, error) {
-	var err error
+// waitForReadIndex asks the leader for its read index and waits until the
+// local store applied it. Stale reads served afterwards are linearizable,
+// so followers can answer consistent reads themselves.
+func (s *Server) waitForReadIndex(ctx context.Context) error {
+	cc, ctx, release, err := s.leaderConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 	timeCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
-	s.leaderConn, err = grpc.DialContext(timeCtx, leaderGrpcAddr, grpc.WithInsecure(), grpc.WithBlock())
+	rsp, err := rpcservicepb.NewRpcServiceClient(cc).ReadIndex(timeCtx, &rpcservicepb.ReadIndexReq{})
+	if err != nil {
+		return err
+	}
+	return s.store.WaitForAppliedIndex(rsp.Index, readIndexTimeout)
+}
+
+// checkBounded enforces the staleness bound of reads at the "bounded"
+// level, which are otherwise served like stale ones. A node lagging too far
+// behind answers ecode.StaleRead, naming the leader to retry against.
+func (s *Server) checkBounded(maxLagEntries uint64, maxStalenessMs int64) error {
+	err := s.store.CheckStaleness(core.StalenessBound{
+		MaxLagEntries: maxLagEntries,
+		MaxStaleness:  time.Duration(maxStalenessMs) * time.Millisecond,
+	})
+	if staleErr, ok := err.(*core.StaleReadError); ok {
+		return ecode.StaleRead(s.store.LeaderAPIAddr(), staleErr.Error(), staleErr.LagEntries, staleErr.Staleness)
+	}
+	return err
+}
+
+// parseLevel maps the level of read requests to a core.ConsistencyLevel
+func parseLevel(level string) core.ConsistencyLevel {
+	switch level {
+	case "default":
+		return core.Default
+	case "stale", "bounded":
+		return core.Stale
+	case "consistent":
+		return core.Consistent
+	case "lease":
+		return core.LeaseRead
+	default:
+		return core.Default
+	}
+}
+
+func (s *Server) Set(ctx context.Context, req *rpcservicepb.SetReq) (*rpcservicepb.SetRsp, error) {
+	if req.Ttl != 0 && req.Lease != 0 {
+		return nil, status.Error(codes.InvalidArgument, core.ErrTTLWithLease.Error())
+	}
+	r, err := s.store.SetWithOptions(req.Key, req.Value, core.WriteOptions{
+		PrevRevision: req.PrevRevision,
+		TTL:          time.Duration(req.Ttl) * time.Second,
+		Lease:        req.Lease,
+	})
 	if err != nil {
 		return nil, err
 	}
-	rpcserviceClient = rpcservicepb.NewRpcServiceClient(s.leaderConn)
-	rsp, err := rpcserviceClient.Get(timeCtx, &rpcservicepb.GetReq{Key: key})
+	return &rpcservicepb.SetRsp{Succeeded: r.Succeeded, Revision: r.Revision}, nil
+}
+
+func (s *Server) Delete(ctx context.Context, req *rpcservicepb.DeleteReq) (*rpcservicepb.DeleteRsp, error) {
+	r, err := s.store.DeleteWithOptions(req.Key, core.WriteOptions{PrevRevision: req.PrevRevision})
 	if err != nil {
 		return nil, err
 	}
-	return rsp, nil
+	return &rpcservicepb.DeleteRsp{Succeeded: r.Succeeded, Revision: r.Revision}, nil
 }
 
-func (s *Server) verifyLeaderConnReDial(ctx context.Context, req interface{}, typeID int64) (interface{}, error) {
-	leaderGrpcAddr := s.store.LeaderAPIAddr()
-	if leaderGrpcAddr == "" {
-		return nil, ecode.ServiceUnavailable
+func (s *Server) Join(ctx context.Context, req *rpcservicepb.JoinReq) (*rpcservicepb.JoinRsp, error) {
+	if err := s.store.Join(req.NodeID, req.GrpcAddr, req.RaftAddr, req.Nonvoter); err != nil {
+		return nil, err
 	}
-	switch typeID {
-	case GetTypeID:
-		if s.leaderConn == nil {
-			rsp, err := s.get(ctx, leaderGrpcAddr, req.(*rpcservicepb.GetReq).Key)
-			if err != nil {
-				return nil, err
-			}
-			return rsp, err
+	return &rpcservicepb.JoinRsp{}, nil
+}
+
+func (s *Server) Batch(ctx context.Context, req *rpcservicepb.BatchReq) (*rpcservicepb.BatchRsp, error) {
+	if len(req.Ops) == 0 {
+		return nil, ecode.BadRequest
+	}
+	ops, err := toCoreOps(req.Ops, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.ApplyBatch(ops); err != nil {
+		return nil, err
+	}
+	return &rpcservicepb.BatchRsp{}, nil
+}
+
+// toCoreOps converts the ops of a Batch or Txn request, GET ops are only
+// accepted if allowGet is set.
+func toCoreOps(pbOps []*rpcservicepb.BatchOp, allowGet bool) ([]core.BatchOp, error) {
+	ops := make([]core.BatchOp, 0, len(pbOps))
+	for _, op := range pbOps {
+		if op.Key == "" {
+			return nil, ecode.BadRequest
 		}
-		if leaderGrpcAddr == s.leaderConn.Target() {
-			rsp, err := rpcserviceClient.Get(ctx, req.(*rpcservicepb.GetReq))
-			if err != nil {
-				return nil, err
+		switch op.Type {
+		case rpcservicepb.BatchOp_SET:
+			ops = append(ops, core.BatchOp{Op: core.OpSet, Key: op.Key, Value: op.Value})
+		case rpcservicepb.BatchOp_DELETE:
+			ops = append(ops, core.BatchOp{Op: core.OpDelete, Key: op.Key})
+		case rpcservicepb.BatchOp_GET:
+			if !allowGet {
+				return nil, ecode.BadRequest
 			}
-			return rsp, nil
+			ops = append(ops, core.BatchOp{Op: core.OpGet, Key: op.Key})
+		default:
+			return nil, ecode.BadRequest
 		}
-		rsp, err := s.get(ctx, leaderGrpcAddr, req.(*rpcservicepb.GetReq).Key)
-		if err != nil {
-			return nil, err
-		}
-		return rsp, nil
+	}
+	return ops, nil
+}
 
-	case SetTypeID:
-		if s.leaderConn == nil {
-			rsp, err := s.set(ctx, leaderGrpcAddr, req.(*rpcservicepb.SetReq).Key, req.(*rpcservicepb.SetReq).Value)
-			if err != nil {
-				return nil, err
+func (s *Server) CompareAndSwap(ctx context.Context, req *rpcservicepb.CompareAndSwapReq) (*rpcservicepb.CompareAndSwapRsp, error) {
+	if req.Key == "" {
+		return nil, ecode.BadRequest
+	}
+	succeeded, err := s.store.CompareAndSwap(req.Key, req.Expected, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcservicepb.CompareAndSwapRsp{Succeeded: succeeded}, nil
+}
+
+func (s *Server) SetIfAbsent(ctx context.Context, req *rpcservicepb.SetIfAbsentReq) (*rpcservicepb.SetIfAbsentRsp, error) {
+	if req.Key == "" {
+		return nil, ecode.BadRequest
+	}
+	succeeded, err := s.store.SetIfAbsent(req.Key, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcservicepb.SetIfAbsentRsp{Succeeded: succeeded}, nil
+}
+
+func (s *Server) DeleteIfValue(ctx context.Context, req *rpcservicepb.DeleteIfValueReq) (*rpcservicepb.DeleteIfValueRsp, error) {
+	if req.Key == "" {
+		return nil, ecode.BadRequest
+	}
+	succeeded, err := s.store.DeleteIfValue(req.Key, req.Expected)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcservicepb.DeleteIfValueRsp{Succeeded: succeeded}, nil
+}
+
+func (s *Server) LeaseGrant(ctx context.Context, req *rpcservicepb.LeaseGrantReq) (*rpcservicepb.LeaseGrantRsp, error) {
+	id, err := s.store.GrantLease(time.Duration(req.Ttl) * time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcservicepb.LeaseGrantRsp{Id: id, Ttl: req.Ttl}, nil
+}
+
+func (s *Server) LeaseKeepAlive(ctx context.Context, req *rpcservicepb.LeaseKeepAliveReq) (*rpcservicepb.LeaseKeepAliveRsp, error) {
+	ttl, err := s.store.KeepAliveLease(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcservicepb.LeaseKeepAliveRsp{Id: req.Id, Ttl: int64(ttl / time.Second)}, nil
+}
+
+func (s *Server) LeaseRevoke(ctx context.Context, req *rpcservicepb.LeaseRevokeReq) (*rpcservicepb.LeaseRevokeRsp, error) {
+	err := s.store.RevokeLease(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcservicepb.LeaseRevokeRsp{}, nil
+}
+
+// Watch streams the changes of a key or prefix. It is served by the local
+// store and never forwarded, so followers can take the watch load.
+func (s *Server) Watch(req *rpcservicepb.WatchReq, stream rpcservicepb.RpcService_WatchServer) error {
+	w, err := s.store.Watch(req.Key, req.Prefix, req.StartRevision)
+	if err != nil {
+		return err
+	}
+	defer w.Cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case ev, ok := <-w.Events():
+			if !ok {
+				return w.Err()
 			}
-			return rsp, err
-		}
-		if leaderGrpcAddr == s.leaderConn.Target() {
-			rsp, err := rpcserviceClient.Set(ctx, req.(*rpcservicepb.SetReq))
-			if err != nil {
-				return nil, err
+			rsp := &rpcservicepb.WatchRsp{
+				Type:     rpcservicepb.WatchRsp_PUT,
+				Key:      ev.Key,
+				Value:    ev.Value,
+				Revision: ev.Revision,
 			}
-			return rsp, nil
-		}
-		rsp, err := s.set(ctx, leaderGrpcAddr, req.(*rpcservicepb.SetReq).Key, req.(*rpcservicepb.SetReq).Value)
-		if err != nil {
-			return nil, err
-		}
-		return rsp, nil
-
-	case DeleteTypeID:
-		if s.leaderConn == nil {
-			rsp, err := s.delete(ctx, leaderGrpcAddr, req.(*rpcservicepb.DeleteReq).Key)
-			if err != nil {
-				return nil, err
+			if ev.Type == core.EventDelete {
+				rsp.Type = rpcservicepb.WatchRsp_DELETE
 			}
-			return rsp, err
-		}
-		if leaderGrpcAddr == s.leaderConn.Target() {
-			rsp, err := rpcserviceClient.Delete(ctx, req.(*rpcservicepb.DeleteReq))
-			if err != nil {
-				return nil, err
+			if err := stream.Send(rsp); err != nil {
+				return err
 			}
-			return rsp, nil
-		}
-		rsp, err := s.delete(ctx, leaderGrpcAddr, req.(*rpcservicepb.DeleteReq).Key)
-		if err != nil {
-			return nil, err
 		}
-		return rsp, nil
+	}
+}
 
-	case JoinTypeID:
-		if s.leaderConn == nil {
-			rsp, err := s.join(ctx, leaderGrpcAddr, req.(*rpcservicepb.JoinReq).GrpcAddr, req.(*rpcservicepb.JoinReq).RaftAddr, req.(*rpcservicepb.JoinReq).NodeID)
-			if err != nil {
-				return nil, err
-			}
-			return rsp, err
+func (s *Server) Range(ctx context.Context, req *rpcservicepb.RangeReq) (*rpcservicepb.RangeRsp, error) {
+	if req.Limit < 0 {
+		return nil, ecode.BadRequest
+	}
+	opts := core.RangeOptions{
+		Start:     req.Start,
+		End:       req.End,
+		Prefix:    req.Prefix,
+		Limit:     int(req.Limit),
+		KeysOnly:  req.KeysOnly,
+		CountOnly: req.CountOnly,
+		Continue:  req.Continuation,
+	}
+	if req.Level == "bounded" {
+		if err := s.checkBounded(req.MaxLagEntries, req.MaxStalenessMs); err != nil {
+			return nil, err
 		}
-		if leaderGrpcAddr == s.leaderConn.Target() {
-			rsp, err := rpcserviceClient.Join(ctx, req.(*rpcservicepb.JoinReq))
-			if err != nil {
-				return nil, err
-			}
-			return rsp, nil
+	}
+	level := parseLevel(req.Level)
+	rr, err := s.store.Range(opts, level)
+	if err == core.ErrNotLeader && level == core.Consistent {
+		if err := s.waitForReadIndex(ctx); err != nil {
+			return nil, err
 		}
-		rsp, err := s.join(ctx, leaderGrpcAddr, req.(*rpcservicepb.JoinReq).GrpcAddr, req.(*rpcservicepb.JoinReq).RaftAddr, req.(*rpcservicepb.JoinReq).NodeID)
-		if err != nil {
+		rr, err = s.store.Range(opts, core.Stale)
+	}
+	if err != nil {
+		if err == core.ErrNotLeader {
 			return nil, err
 		}
-		return rsp, nil
-	default:
-		return nil, ecode.NoTypeIDError
+		if err == core.ErrInvalidContinue {
+			return nil, ecode.BadRequest
+		}
+		return nil, ecode.InternalServerError
+	}
+	rsp := &rpcservicepb.RangeRsp{
+		Kvs:          make([]*rpcservicepb.KeyValue, 0, len(rr.KVs)),
+		Count:        int64(rr.Count),
+		More:         rr.More,
+		Continuation: rr.Continue,
 	}
+	for _, kv := range rr.KVs {
+		rsp.Kvs = append(rsp.Kvs, toPbKeyValue(kv))
+	}
+	return rsp, nil
+}
 
+func toPbKeyValue(kv *core.KeyValue) *rpcservicepb.KeyValue {
+	return &rpcservicepb.KeyValue{
+		Key:            kv.Key,
+		Value:          kv.Value,
+		CreateRevision: kv.CreateRevision,
+		ModRevision:    kv.ModRevision,
+		Version:        kv.Version,
+		Lease:          kv.Lease,
+	}
 }
 
-func (s *Server) Set(ctx context.Context, req *rpcservicepb.SetReq) (*rpcservicepb.SetRsp, error) {
-	if err := s.store.Set(req.Key, req.Value); err != nil {
-		if err == core.ErrNotLeader {
-			rsp, err := s.verifyLeaderConnReDial(ctx, req, SetTypeID)
-			if err != nil {
-				return nil, err
-			}
-			return rsp.(*rpcservicepb.SetRsp), nil
+func (s *Server) Txn(ctx context.Context, req *rpcservicepb.TxnReq) (*rpcservicepb.TxnRsp, error) {
+	compares := make([]core.Compare, 0, len(req.Compares))
+	for _, cmp := range req.Compares {
+		if cmp.Key == "" {
+			return nil, ecode.BadRequest
 		}
+		compares = append(compares, core.Compare{
+			Key:    cmp.Key,
+			Target: core.CompareTarget(cmp.Target),
+			Result: core.CompareResult(cmp.Result),
+			Value:  cmp.Value,
+			Number: cmp.Number,
+		})
+	}
+	success, err := toCoreOps(req.Success, true)
+	if err != nil {
 		return nil, err
 	}
-	return &rpcservicepb.SetRsp{}, nil
-}
-
-func (s *Server) set(ctx context.Context, leaderGrpcAddr, key, value string) (interface{}, error) {
-	var err error
-	timeCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
-	defer cancel()
-	s.leaderConn, err = grpc.DialContext(timeCtx, leaderGrpcAddr, grpc.WithInsecure(), grpc.WithBlock())
+	failure, err := toCoreOps(req.Failure, true)
 	if err != nil {
 		return nil, err
 	}
-	rpcserviceClient = rpcservicepb.NewRpcServiceClient(s.leaderConn)
-	rsp, err := rpcserviceClient.Set(timeCtx, &rpcservicepb.SetReq{Key: key, Value: value})
+
+	tr, err := s.store.Txn(compares, success, failure)
 	if err != nil {
 		return nil, err
 	}
+	rsp := &rpcservicepb.TxnRsp{
+		Succeeded: tr.Succeeded,
+		Revision:  tr.Revision,
+		Results:   make([]*rpcservicepb.TxnOpResult, 0, len(tr.Results)),
+	}
+	for _, r := range tr.Results {
+		result := &rpcservicepb.TxnOpResult{Deleted: r.Deleted}
+		if r.KV != nil {
+			result.Kv = toPbKeyValue(r.KV)
+		}
+		rsp.Results = append(rsp.Results, result)
+	}
 	return rsp, nil
 }
 
-func (s *Server) Delete(ctx context.Context, req *rpcservicepb.DeleteReq) (*rpcservicepb.DeleteRsp, error) {
-	if err := s.store.Delete(req.Key); err != nil {
-		if err == core.ErrNotLeader {
-			rsp, err := s.verifyLeaderConnReDial(ctx, req, DeleteTypeID)
-			if err != nil {
-				return nil, err
-			}
-			return rsp.(*rpcservicepb.DeleteRsp), nil
-		}
+// ReadIndex returns the commit index of the leader, see core.Store.ReadIndex
+func (s *Server) ReadIndex(ctx context.Context, req *rpcservicepb.ReadIndexReq) (*rpcservicepb.ReadIndexRsp, error) {
+	idx, err := s.store.ReadIndex()
+	if err != nil {
 		return nil, err
 	}
-	return &rpcservicepb.DeleteRsp{}, nil
+	return &rpcservicepb.ReadIndexRsp{Index: idx}, nil
 }
 
-func (s *Server) delete(ctx context.Context, leaderGrpcAddr, key string) (interface{}, error) {
-	var err error
-	timeCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
-	defer cancel()
-	s.leaderConn, err = grpc.DialContext(timeCtx, leaderGrpcAddr, grpc.WithInsecure(), grpc.WithBlock())
-	if err != nil {
-		return nil, err
+// TransferLeadership hands the leadership over to another voter, see
+// core.Store.TransferLeadership. Followers forward it to the leader.
+func (s *Server) TransferLeadership(ctx context.Context, req *rpcservicepb.TransferLeadershipReq) (*rpcservicepb.TransferLeadershipRsp, error) {
+	if err := s.store.TransferLeadership(req.NodeID); err != nil {
+		return nil, membershipError(err)
 	}
-	rpcserviceClient = rpcservicepb.NewRpcServiceClient(s.leaderConn)
-	rsp, err := rpcserviceClient.Delete(timeCtx, &rpcservicepb.DeleteReq{Key: key})
+	return &rpcservicepb.TransferLeadershipRsp{}, nil
+}
+
+// RemoveNode removes a node from the cluster, see core.Store.RemoveNode.
+// Followers forward it to the leader.
+func (s *Server) RemoveNode(ctx context.Context, req *rpcservicepb.RemoveNodeReq) (*rpcservicepb.RemoveNodeRsp, error) {
+	if req.NodeID == "" {
+		return nil, ecode.BadRequest
+	}
+	if err := s.store.RemoveNode(req.NodeID); err != nil {
+		return nil, membershipError(err)
+	}
+	return &rpcservicepb.RemoveNodeRsp{}, nil
+}
+
+// DemoteVoter turns a voter into a nonvoter, see core.Store.DemoteVoter.
+// Followers forward it to the leader.
+func (s *Server) DemoteVoter(ctx context.Context, req *rpcservicepb.DemoteVoterReq) (*rpcservicepb.DemoteVoterRsp, error) {
+	if req.NodeID == "" {
+		return nil, ecode.BadRequest
+	}
+	if err := s.store.DemoteVoter(req.NodeID); err != nil {
+		return nil, membershipError(err)
+	}
+	return &rpcservicepb.DemoteVoterRsp{}, nil
+}
+
+// ListMembers returns the servers of the cluster as the leader sees them.
+// Followers forward it to the leader.
+func (s *Server) ListMembers(ctx context.Context, req *rpcservicepb.ListMembersReq) (*rpcservicepb.ListMembersRsp, error) {
+	members, err := s.store.Members()
 	if err != nil {
 		return nil, err
 	}
+	rsp := &rpcservicepb.ListMembersRsp{}
+	for _, m := range members {
+		rsp.Members = append(rsp.Members, &rpcservicepb.Member{
+			Id:       m.ID,
+			RaftAddr: m.RaftAddr,
+			GrpcAddr: m.GrpcAddr,
+			Suffrage: m.Suffrage,
+			Leader:   m.Leader,
+		})
+	}
 	return rsp, nil
 }
 
-func (s *Server) Join(ctx context.Context, req *rpcservicepb.JoinReq) (*rpcservicepb.JoinRsp, error) {
-	if err := s.store.Join(req.NodeID, req.GrpcAddr, req.RaftAddr); err != nil {
-		if err == core.ErrNotLeader {
-			rsp, err := s.verifyLeaderConnReDial(ctx, req, JoinTypeID)
-			if err != nil {
-				return nil, err
-			}
-			return rsp.(*rpcservicepb.JoinRsp), nil
-		}
+// Promote turns a nonvoter into a voter once it caught up, see
+// core.Store.Promote. The leader asks the node how far it applied the log.
+// Followers forward it to the leader.
+func (s *Server) Promote(ctx context.Context, req *rpcservicepb.PromoteReq) (*rpcservicepb.PromoteRsp, error) {
+	if req.NodeID == "" {
+		return nil, ecode.BadRequest
+	}
+	members, err := s.store.Members()
+	if err != nil {
 		return nil, err
 	}
-	return &rpcservicepb.JoinRsp{}, nil
+
+	var grpcAddr string
+	for _, m := range members {
+		if m.ID == req.NodeID {
+			grpcAddr = m.GrpcAddr
+		}
+	}
+	if grpcAddr == "" {
+		return nil, membershipError(core.ErrNodeNotFound)
+	}
+	st, err := s.status(ctx, grpcAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "get status of node %s: %v", req.NodeID, err)
+	}
+	if err := s.store.Promote(req.NodeID, st.AppliedIndex); err != nil {
+		return nil, membershipError(err)
+	}
+	return &rpcservicepb.PromoteRsp{}, nil
 }
 
-func (s *Server) join(ctx context.Context, leaderGrpcAddr, grpcAddr, raftAddr, nodeID string) (interface{}, error) {
-	var err error
+// status asks the node at grpcAddr for its raft progress
+func (s *Server) status(ctx context.Context, grpcAddr string) (*rpcservicepb.StatusRsp, error) {
 	timeCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
 	defer cancel()
-	s.leaderConn, err = grpc.DialContext(timeCtx, leaderGrpcAddr, grpc.WithInsecure(), grpc.WithBlock())
+	client, release, err := s.conns.client(grpcAddr)
 	if err != nil {
 		return nil, err
 	}
-	rpcserviceClient = rpcservicepb.NewRpcServiceClient(s.leaderConn)
-	rsp, err := rpcserviceClient.Join(timeCtx, &rpcservicepb.JoinReq{GrpcAddr: grpcAddr, RaftAddr: raftAddr, NodeID: nodeID})
+	defer release()
+	return client.Status(timeCtx, &rpcservicepb.StatusReq{})
+}
+
+// Status returns the raft progress of this node. It is not forwarded.
+func (s *Server) Status(ctx context.Context, req *rpcservicepb.StatusReq) (*rpcservicepb.StatusRsp, error) {
+	st := s.store.Status()
+	return &rpcservicepb.StatusRsp{
+		NodeID:       st.ID,
+		State:        st.State,
+		AppliedIndex: st.AppliedIndex,
+		LastLogIndex: st.LastLogIndex,
+	}, nil
+}
+
+// membershipError maps the errors of membership changes to grpc statuses
+func membershipError(err error) error {
+	switch {
+	case err == core.ErrNodeNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case err == core.ErrRemoveLeader, errors.Is(err, core.ErrNotCaughtUp):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+// Snapshot makes this node take a raft snapshot now. It is not forwarded
+// since every node snapshots its own state.
+func (s *Server) Snapshot(ctx context.Context, req *rpcservicepb.SnapshotReq) (*rpcservicepb.SnapshotRsp, error) {
+	info, err := s.store.TakeSnapshot()
 	if err != nil {
+		if err == core.ErrNothingNewToSnapshot {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, err
 	}
-	return rsp, nil
+	return &rpcservicepb.SnapshotRsp{Id: info.ID, Index: info.Index, Term: info.Term, Size_: info.Size}, nil
+}
+
+// Backup streams a point-in-time backup taken by the leader, see
+// core.Store.Backup. Followers relay the stream of the leader.
+func (s *Server) Backup(req *rpcservicepb.BackupReq, stream rpcservicepb.RpcService_BackupServer) error {
+	bw := bufio.NewWriterSize(backupWriter{stream}, backupChunkSize)
+	if err := s.store.Backup(bw); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// backupWriter sends what is written to it as BackupRsp messages
+type backupWriter struct {
+	stream rpcservicepb.RpcService_BackupServer
+}
+
+func (w backupWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&rpcservicepb.BackupRsp{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
 }
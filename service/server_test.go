@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"raft-grpc-demo/client"
+	"raft-grpc-demo/ecode"
+	rpcservicepb "raft-grpc-demo/proto"
+)
+
+func TestConsistentReadOnFollower(t *testing.T) {
+	leader, _ := startServer(t, &fakeStore{isLead: true, readIndex: 42}, ForwardProxy)
+
+	// A follower that applied the read index of the leader serves the read
+	// from its own state.
+	_, follower := startServer(t, &fakeStore{leader: leader, applied: 42, value: "local"}, ForwardProxy)
+	rsp, err := follower.Get(context.Background(), &rpcservicepb.GetReq{Key: "a", Level: "consistent"})
+	assert.Nil(t, err)
+	assert.Equal(t, "local", rsp.Value)
+
+	// One behind does not.
+	_, behind := startServer(t, &fakeStore{leader: leader, applied: 41, value: "local"}, ForwardProxy)
+	_, err = behind.Get(context.Background(), &rpcservicepb.GetReq{Key: "a", Level: "consistent"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "index 42 not applied")
+}
+
+func TestBoundedRead(t *testing.T) {
+	store := &fakeStore{leader: "127.0.0.1:51000", value: "local", lag: 3, staleness: 200 * time.Millisecond}
+	srv := &Server{store: store}
+	tests := []struct {
+		name           string
+		checkErr       error
+		maxLagEntries  uint64
+		maxStalenessMs int64
+		code           codes.Code
+	}{
+		{"no bound", nil, 0, 0, codes.OK},
+		{"within bound", nil, 3, 200, codes.OK},
+		{"lagging", nil, 2, 0, codes.FailedPrecondition},
+		{"stale", nil, 0, 100, codes.FailedPrecondition},
+		{"check failing", errors.New("stats unavailable"), 3, 200, codes.Unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.checkErr = tt.checkErr
+			err := srv.checkBounded(tt.maxLagEntries, tt.maxStalenessMs)
+			assert.Equal(t, tt.code, status.Code(err))
+			if tt.code == codes.FailedPrecondition {
+				// The caller is told where to retry.
+				st, _ := status.FromError(err)
+				assert.Len(t, st.Details(), 1)
+				info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+				assert.True(t, ok)
+				assert.Equal(t, ecode.ReasonStaleRead, info.Reason)
+				assert.Equal(t, map[string]string{"leader": "127.0.0.1:51000", "lag_entries": "3", "staleness_ms": "200"}, info.Metadata)
+				hint, ok := ecode.LeaderHint(err)
+				assert.True(t, ok)
+				assert.Equal(t, "127.0.0.1:51000", hint)
+			}
+			if tt.checkErr != nil {
+				assert.Equal(t, tt.checkErr, err)
+			}
+		})
+	}
+
+	// Bounded reads are served from the local state once within bound.
+	store.checkErr = nil
+	_, bounded := startServer(t, store, ForwardProxy)
+	rsp, err := bounded.Get(context.Background(), &rpcservicepb.GetReq{Key: "a", Level: "bounded", MaxLagEntries: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, "local", rsp.Value)
+	_, err = bounded.Get(context.Background(), &rpcservicepb.GetReq{Key: "a", Level: "bounded", MaxLagEntries: 2})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Clients following leader hints retry out of bound reads on the
+	// leader.
+	leader, _ := startServer(t, &fakeStore{isLead: true, value: "leader"}, ForwardProxy)
+	addr, _ := startServer(t, &fakeStore{leader: leader, value: "local", lag: 3}, ForwardProxy)
+	redirect := client.NewLeaderRedirect()
+	defer redirect.Close()
+	cc, err := grpc.Dial(addr, append(redirect.DialOptions(), grpc.WithInsecure())...)
+	assert.Nil(t, err)
+	defer cc.Close()
+	rsp, err = rpcservicepb.NewRpcServiceClient(cc).Get(context.Background(), &rpcservicepb.GetReq{Key: "a", Level: "bounded", MaxLagEntries: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, "leader", rsp.Value)
+}
+
+func TestSetTTLWithLease(t *testing.T) {
+	_, leader := startServer(t, &fakeStore{isLead: true}, ForwardProxy)
+	_, err := leader.Set(context.Background(), &rpcservicepb.SetReq{Key: "a", Value: "1", Ttl: 5, Lease: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}